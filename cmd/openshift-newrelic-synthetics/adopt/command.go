@@ -0,0 +1,213 @@
+package adopt
+
+import (
+	"fmt"
+	"os"
+
+	routeclient "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/alecthomas/kingpin.v2"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/adopt"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/account"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+)
+
+type command struct {
+	NewRelicAccount     account.Account
+	NewRelicLimits      api.Limits
+	KubernetesMasterURL string
+	KubernetesConfig    string
+	DryRun              bool
+	Report              report.Output
+	Namespace           string
+}
+
+func (cmd *command) run(c *kingpin.ParseContext) error {
+	config, err := clientcmd.BuildConfigFromFlags(cmd.KubernetesMasterURL, cmd.KubernetesConfig)
+	if err != nil {
+		return err
+	}
+
+	routeClient, err := routeclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	coreClient, err := coreclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	acc, err := account.Resolve(coreClient, cmd.Namespace, cmd.NewRelicAccount)
+	if err != nil {
+		return err
+	}
+
+	client, err := acc.Client(cmd.NewRelicLimits)
+	if err != nil {
+		return err
+	}
+
+	defer client.LogCalls()
+
+	return cmd.execute(client, routeClient)
+}
+
+// Helper function to adopt the monitors for the Routes in the namespace and report on the results.
+func (cmd *command) execute(client *api.Client, routeClient routeclient.RoutesGetter) error {
+	routes, err := routeutils.List(routeClient, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	monitors, err := monitorutils.List(client)
+	if err != nil {
+		return err
+	}
+
+	plan, err := adopt.Plan(client, monitors, routes)
+	if err != nil {
+		return err
+	}
+
+	if len(plan) == 0 {
+		log.Infoln("No monitors were found which can be adopted")
+		return nil
+	}
+
+	err = adopt.Print(os.Stdout, plan)
+	if err != nil {
+		return err
+	}
+
+	rpt := report.New()
+
+	ids := make(map[string]string)
+	results := make(map[string]*report.Result)
+
+	for _, adoption := range plan {
+		logger := log.WithFields(log.Fields{
+			"namespace": adoption.Route.ObjectMeta.Namespace,
+			"name":      adoption.Route.ObjectMeta.Name,
+			"url":       adoption.URL,
+		})
+
+		result := &report.Result{
+			Namespace: adoption.Route.ObjectMeta.Namespace,
+			Route:     adoption.Route.ObjectMeta.Name,
+			URL:       adoption.URL,
+			Monitor:   adoption.URL,
+			Action:    report.ActionSkipped,
+		}
+
+		rpt.Add(result)
+
+		monitor, ok := adoption.Monitor()
+		if !ok {
+			logger.Warnln("Skipping. Unable to adopt a monitor because", adoption.Reason())
+			result.Reason = adoption.Reason()
+			continue
+		}
+
+		result.MonitorID = monitor.ID
+
+		if cmd.DryRun {
+			logger.Infoln("Dry run is enabled. The following monitor would have been adopted:", monitor.Name)
+			result.Action = report.ActionDryRun
+			result.Reason = fmt.Sprintf("monitor %q would have been adopted", monitor.Name)
+			continue
+		}
+
+		previous := monitor.Name
+
+		logger.Infoln("Adopting monitor:", previous)
+
+		err := adopt.Rename(client, monitor, adoption.URL)
+		if err != nil {
+			logger.Errorln("Failed to rename monitor:", err)
+			result.Action = report.ActionFailed
+			result.Error = fmt.Errorf("failed to rename monitor: %w", err)
+			continue
+		}
+
+		result.Action = report.ActionAdopted
+		result.Reason = fmt.Sprintf("adopted monitor %q", previous)
+		result.Tags = entityutils.RouteTags(adoption.Route)
+
+		ids[adoption.URL] = monitor.ID
+		results[adoption.URL] = result
+	}
+
+	if len(ids) > 0 {
+		err := cmd.tag(client, ids, results)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = rpt.Summary(os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Report.Write(rpt, "adopt")
+	if err != nil {
+		return err
+	}
+
+	return rpt.Err()
+}
+
+// Helper function to tag the adopted monitors so they are managed from now on.
+func (cmd *command) tag(client *api.Client, ids map[string]string, results map[string]*report.Result) error {
+	list, err := entityutils.Lookup(client, entityutils.TagOpenShiftRouteNamespace, cmd.Namespace, ids)
+	if err != nil {
+		return fmt.Errorf("failed to lookup adopted monitors: %w", err)
+	}
+
+	for _, entity := range list {
+		result := results[entity.Name]
+		result.GUID = entity.GUID
+		result.Permalink = entity.Permalink
+
+		_, err := entityutils.ReconcileTags(client, entity.GUID, entity.Tags, result.Tags)
+		if err != nil {
+			log.WithField("name", entity.Name).Errorln("Failed to tag adopted monitor:", err)
+			result.Action = report.ActionFailed
+			result.Error = fmt.Errorf("failed to tag adopted monitor: %w", err)
+		}
+
+		delete(ids, entity.Name)
+	}
+
+	for name := range ids {
+		log.WithField("name", name).Warnln("Unable to tag the adopted monitor because its entity could not be found, tags will be applied by the next sync")
+	}
+
+	return nil
+}
+
+// Command which adopts monitors which were created by hand.
+func Command(app *kingpin.Application) {
+	c := new(command)
+
+	command := app.Command("adopt", "Adopt monitors which were created by hand for OpenShift Routes instead of creating duplicates").Action(c.run)
+
+	c.NewRelicAccount.Flags(command)
+	c.NewRelicLimits.Flags(command)
+
+	command.Flag("kubernetes-master-url", "URL of the Kubernetes master").Envar("KUBERNETES_MASTER_URL").StringVar(&c.KubernetesMasterURL)
+	command.Flag("kubernetes-config", "Path to the Kubernetes config file").Envar("KUBERNETES_CONFIG").StringVar(&c.KubernetesConfig)
+
+	command.Flag("dry-run", "Print out the monitors which would have been adopted").Envar("DRY_RUN").BoolVar(&c.DryRun)
+	c.Report.Flags(command)
+
+	command.Arg("namespace", "Namespace where Routes will be queried").Required().StringVar(&c.Namespace)
+}
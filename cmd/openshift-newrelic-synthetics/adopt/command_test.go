@@ -0,0 +1,137 @@
+package adopt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubefake "github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/fake"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/fake"
+)
+
+const namespace = "test"
+
+func newClient(t *testing.T, server *fake.Server) *api.Client {
+	client, err := api.New(api.Limits{Retries: 2, Backoff: time.Millisecond}, server.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func newRoute(name string) *routev1.Route {
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: routev1.RouteSpec{
+			Host: name + ".example.com",
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: name,
+			},
+			TLS: &routev1.TLSConfig{},
+		},
+	}
+}
+
+func TestAdopt(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	id := server.AddMonitor(synthetics.Monitor{
+		Name: "Homepage",
+		Type: synthetics.MonitorTypes.Ping,
+		URI:  "http://WEB.example.com:80/",
+	}, entities.Tag{Key: "team", Values: []string{"platform"}})
+
+	kube := kubefake.New(newRoute("web"))
+
+	cmd := &command{
+		Namespace: namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, ok := server.Monitor("https://web.example.com")
+	if !ok || monitor.ID != id {
+		t.Fatalf("expected monitor %s to be renamed, got %+v", id, server.Monitors())
+	}
+
+	if monitor.URI != "https://web.example.com" {
+		t.Errorf("expected the URI to be set to the route URL, got %q", monitor.URI)
+	}
+
+	tags := server.Tags(id)
+
+	if got := tags[entityutils.TagOpenShiftRouteName]; len(got) != 1 || got[0] != "web" {
+		t.Errorf("expected name tag %q, got %v", "web", got)
+	}
+
+	if got := tags["team"]; len(got) != 1 || got[0] != "platform" {
+		t.Errorf("expected unmanaged tag to be kept, got %v", got)
+	}
+}
+
+func TestAdoptSkips(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	// Ambiguous, two monitors match the same Route.
+	server.AddMonitor(synthetics.Monitor{Name: "One", URI: "https://one.example.com"})
+	server.AddMonitor(synthetics.Monitor{Name: "One again", URI: "https://one.example.com/"})
+
+	// Already managed for a different Route.
+	server.AddMonitor(synthetics.Monitor{Name: "Two", URI: "https://two.example.com"},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteNamespace, Values: []string{"other"}},
+	)
+
+	kube := kubefake.New(newRoute("one"), newRoute("two"))
+
+	cmd := &command{
+		Namespace: namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := server.Requests(fake.OperationUpdateMonitor); requests != 0 {
+		t.Errorf("expected no monitors to be renamed, got %d", requests)
+	}
+}
+
+func TestAdoptDryRun(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	server.AddMonitor(synthetics.Monitor{Name: "Homepage", URI: "https://web.example.com"})
+
+	kube := kubefake.New(newRoute("web"))
+
+	cmd := &command{
+		DryRun:    true,
+		Namespace: namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := server.Monitor("Homepage"); !ok {
+		t.Error("expected the monitor to keep its name")
+	}
+}
@@ -1,95 +1,430 @@
 package cleanup
 
 import (
-	"github.com/newrelic/newrelic-client-go/newrelic"
-	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
 	routev1 "github.com/openshift/api/route/v1"
+	routeclient "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/alecthomas/kingpin.v2"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/leader"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/metrics"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/account"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
 	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/inventory"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
 	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/safety"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/schedule"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/worker"
 )
 
 type command struct {
-	NewRelicAPIKey      string
+	NewRelicAccount     account.Account
+	NewRelicLimits      api.Limits
 	KubernetesMasterURL string
 	KubernetesConfig    string
 	DryRun              bool
+	Concurrency         int
+	Report              report.Output
+	MetricsAddr         string
+	Interval            time.Duration
+	Election            leader.Election
+	Claims              entityutils.Claims
+	Tombstones          entityutils.Tombstones
+	Safety              safety.Limits
+	RoutesFile          string
 	Namespace           string
 }
 
-func syncSynthetics(client *newrelic.NewRelic, routes []routev1.Route, dryRun bool) error {
-	entities, err := client.Entities.SearchEntities(entities.SearchEntitiesParams{
-		Type: entityutils.TypeMonitor,
-	})
+func (cmd *command) syncSynthetics(client *api.Client, routes []routev1.Route, rpt *report.Report) error {
+	entities, err := entityutils.Search(client, cmd.Namespace)
 	if err != nil {
 		return err
 	}
 
+	now := time.Now()
+
+	var (
+		removals []removal
+		releases []release
+	)
+
 	for _, entity := range entities {
 		logger := log.WithFields(log.Fields{
 			"name": entity.Name,
 		})
 
-		tags, err := client.Entities.ListTags(entity.GUID)
-		if err != nil {
-			return err
+		result := &report.Result{
+			Monitor: entity.Name,
+			GUID:    entity.GUID,
+			Action:  report.ActionSkipped,
 		}
 
-		namespace, name, err := entityutils.GetNamespaceName(tags)
-		if err != nil {
+		rpt.Add(result)
+
+		if _, _, err := entityutils.GetNamespaceName(entity.Tags); err != nil {
 			logger.Error(err)
+			result.Action = report.ActionFailed
+			result.Error = err
 			continue
 		}
 
-		if exists(routes, namespace, name) {
+		owners := entityutils.Owners(entity.Tags)
+		foreign := entityutils.ForeignOwners(entity.Tags, cmd.Namespace)
+
+		for _, owner := range owners {
+			if owner.Namespace == cmd.Namespace {
+				result.Namespace = owner.Namespace
+				result.Route = owner.Name
+				break
+			}
+		}
+
+		for _, tag := range entity.Tags {
+			if tag != nil {
+				result.Tags = append(result.Tags, *tag)
+			}
+		}
+
+		if exists(routes, cmd.Namespace, owners) {
 			logger.Infoln("Skipping. Monitor still has a corresponding OpenShift Route.")
+			result.Reason = "monitor still has a corresponding route"
+			continue
+		}
+
+		if ok, reason := cmd.Claims.Check(entity.Tags, now); !ok {
+			logger.Infoln("Skipping. Monitor is", reason)
+			result.Reason = reason
+			continue
+		}
+
+		// Monitors which are shared with Routes in other namespaces are only deleted once no owner remains.
+		if len(foreign) > 0 {
+			releases = append(releases, release{
+				entity: entity,
+				result: result,
+				owners: foreign,
+				logger: logger,
+			})
+
 			continue
 		}
 
-		if dryRun {
-			logger.Infoln("Dry run is enabled. A monitor would have been deleted.")
+		if entityutils.Protected(entity.Tags) {
+			logger.Infoln("Skipping. Monitor is protected by the tag:", entityutils.TagDoNotDelete)
+			result.Reason = fmt.Sprintf("monitor is protected by the %s tag", entityutils.TagDoNotDelete)
 			continue
 		}
 
-		err = client.Synthetics.DeleteMonitor(entity.Name)
+		id, err := entityutils.MonitorID(entity.GUID)
 		if err != nil {
-			return err
+			logger.Error(err)
+			result.Action = report.ActionFailed
+			result.Error = err
+			continue
 		}
+
+		result.MonitorID = id
+
+		action := report.ActionDeleted
+
+		if cmd.Tombstones.Enabled() {
+			tombstoned, ok := entityutils.GetTombstone(entity.Tags)
+			if !ok {
+				action = report.ActionDisabled
+			} else if deleteAfter := tombstoned.Add(cmd.Tombstones.GracePeriod); now.Before(deleteAfter) {
+				logger.Infoln("Skipping. Monitor is disabled until", deleteAfter.Format(time.RFC3339))
+				result.Reason = fmt.Sprintf("monitor is disabled and will be deleted after %s", deleteAfter.Format(time.RFC3339))
+				continue
+			}
+		}
+
+		removals = append(removals, removal{
+			id:     id,
+			entity: entity,
+			result: result,
+			action: action,
+			logger: logger,
+		})
 	}
 
-	return nil
-}
+	// Nothing is removed if the run looks like it would remove more than expected.
+	err = cmd.Safety.Check(len(removals), len(entities), len(routes), "Routes")
+	if err != nil {
+		for _, r := range removals {
+			r.result.Reason = "safety limit exceeded"
+		}
 
-func exists(routes []routev1.Route, namespace, name string) bool {
-	for _, route := range routes {
-		if route.ObjectMeta.Namespace != namespace {
+		return err
+	}
+
+	pool := worker.New(cmd.Concurrency)
+
+	for _, r := range releases {
+		r := r
+
+		if cmd.DryRun {
+			r.logger.Infoln("Dry run is enabled. A monitor would have been released to:", ownerList(r.owners))
+			r.result.Action = report.ActionDryRun
+			r.result.Reason = fmt.Sprintf("monitor would have been released to %s", ownerList(r.owners))
 			continue
 		}
 
-		if route.ObjectMeta.Name != name {
+		pool.Go(func() error {
+			cmd.release(client, r)
+			return nil
+		})
+	}
+
+	for _, r := range removals {
+		r := r
+
+		if r.action == report.ActionDisabled {
+			if cmd.DryRun {
+				r.logger.Infoln("Dry run is enabled. A monitor would have been disabled.")
+				r.result.Action = report.ActionDryRun
+				r.result.Reason = "monitor would have been disabled"
+				continue
+			}
+
+			pool.Go(func() error {
+				cmd.disable(client, r, now)
+				return nil
+			})
+
+			continue
+		}
+
+		pool.Go(func() error {
+			inventory.Cascade(client, rpt, r.result, r.entity.Tags, cmd.DryRun)
+			return nil
+		})
+	}
+
+	return pool.Wait()
+}
+
+// A monitor which will be removed because its Route no longer exists.
+type removal struct {
+	id     string
+	entity *entityutils.Entity
+	result *report.Result
+	action report.Action
+	logger *log.Entry
+}
+
+// A monitor which is shared with Routes in other namespaces, so only the owners from this namespace are removed.
+type release struct {
+	entity *entityutils.Entity
+	result *report.Result
+	owners []entityutils.Owner
+	logger *log.Entry
+}
+
+// Helper function to remove the owners from this namespace, leaving the monitor to the owners from other namespaces.
+func (cmd *command) release(client *api.Client, r release) {
+	r.logger.Infoln("Releasing monitor to:", ownerList(r.owners))
+
+	desired := entityutils.Replace(r.entity.Tags, entityutils.OwnerTags(r.entity.Tags, cmd.Namespace, nil))
+
+	_, err := entityutils.ReconcileTags(client, r.entity.GUID, r.entity.Tags, desired)
+	if err != nil {
+		r.logger.Errorln("Failed to release monitor:", err)
+		r.result.Action = report.ActionFailed
+		r.result.Error = fmt.Errorf("failed to release monitor: %w", err)
+		return
+	}
+
+	r.result.Action = report.ActionUpdated
+	r.result.Reason = fmt.Sprintf("monitor is still owned by %s", ownerList(r.owners))
+}
+
+// Helper function to disable a monitor and record a tombstone, so it is deleted once the grace period has passed.
+func (cmd *command) disable(client *api.Client, r removal, now time.Time) {
+	r.logger.Infoln("Disabling monitor")
+
+	err := monitorutils.SetStatus(client, r.id, synthetics.MonitorStatus.Disabled)
+	if err != nil {
+		r.logger.Errorln("Failed to disable monitor:", err)
+		r.result.Action = report.ActionFailed
+		r.result.Error = fmt.Errorf("failed to disable monitor: %w", err)
+		return
+	}
+
+	_, err = entityutils.ReconcileTags(client, r.entity.GUID, r.entity.Tags, cmd.Tombstones.Tags(r.entity.Tags, now))
+	if err != nil {
+		r.logger.Errorln("Failed to tag monitor with tombstone:", err)
+		r.result.Action = report.ActionFailed
+		r.result.Error = fmt.Errorf("failed to tag monitor with tombstone: %w", err)
+		return
+	}
+
+	deleteAfter := now.Add(cmd.Tombstones.GracePeriod)
+
+	r.result.Action = report.ActionDisabled
+	r.result.Reason = fmt.Sprintf("monitor will be deleted after %s", deleteAfter.Format(time.RFC3339))
+}
+
+// Helper function to check if any of the owners from a namespace still exist.
+func exists(routes []routev1.Route, namespace string, owners []entityutils.Owner) bool {
+	for _, owner := range owners {
+		if owner.Namespace != namespace {
 			continue
 		}
 
-		return true
+		for _, route := range routes {
+			if entityutils.RouteOwner(route) == owner {
+				return true
+			}
+		}
 	}
 
 	return false
 }
 
+// Helper function to list owners for logs and reports.
+func ownerList(owners []entityutils.Owner) string {
+	list := make([]string, len(owners))
+
+	for i, owner := range owners {
+		list[i] = owner.String()
+	}
+
+	return strings.Join(list, ", ")
+}
+
 func (cmd *command) run(c *kingpin.ParseContext) error {
-	routes, err := routeutils.List(cmd.KubernetesMasterURL, cmd.KubernetesConfig, cmd.Namespace)
+	// Stdin is consumed by the first run, so every run after it would see no Routes.
+	if cmd.RoutesFile == routeutils.Stdin && cmd.Interval > 0 {
+		return fmt.Errorf("--routes-file=%s cannot be used with --interval because stdin can only be read once", routeutils.Stdin)
+	}
+
+	metrics.Serve(cmd.MetricsAddr)
+
+	// Routes which are loaded from files don't require a cluster.
+	if cmd.RoutesFile != "" {
+		return schedule.Run(cmd.Interval, cmd.once)
+	}
+
+	config, err := clientcmd.BuildConfigFromFlags(cmd.KubernetesMasterURL, cmd.KubernetesConfig)
 	if err != nil {
 		return err
 	}
 
-	client, err := newrelic.New(newrelic.ConfigPersonalAPIKey(cmd.NewRelicAPIKey))
+	return cmd.Election.Run(config, func() error {
+		return schedule.Run(cmd.Interval, cmd.once)
+	})
+}
+
+// Helper function to execute a single run.
+func (cmd *command) once() error {
+	if cmd.RoutesFile != "" {
+		return cmd.offline()
+	}
+
+	config, err := clientcmd.BuildConfigFromFlags(cmd.KubernetesMasterURL, cmd.KubernetesConfig)
+	if err != nil {
+		return err
+	}
+
+	routeClient, err := routeclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	coreClient, err := coreclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	acc, err := account.Resolve(coreClient, cmd.Namespace, cmd.NewRelicAccount)
+	if err != nil {
+		return err
+	}
+
+	client, err := acc.Client(cmd.NewRelicLimits)
+	if err != nil {
+		return err
+	}
+
+	defer client.LogCalls()
+
+	return cmd.execute(client, routeClient)
+}
+
+// Helper function to execute a single run against Routes loaded from files, without a cluster.
+// The API key can't be read from a Secret in this mode.
+func (cmd *command) offline() error {
+	acc, err := cmd.NewRelicAccount.LoadAPIKey(nil)
 	if err != nil {
 		return err
 	}
 
-	return syncSynthetics(client, routes, cmd.DryRun)
+	client, err := acc.Client(cmd.NewRelicLimits)
+	if err != nil {
+		return err
+	}
+
+	defer client.LogCalls()
+
+	return cmd.execute(client, nil)
+}
+
+// Helper function to list the Routes from files when provided, otherwise from the cluster.
+func (cmd *command) routes(client routeclient.RoutesGetter) ([]routev1.Route, error) {
+	if cmd.RoutesFile != "" {
+		return routeutils.Load(cmd.RoutesFile, cmd.Namespace)
+	}
+
+	return routeutils.List(client, cmd.Namespace)
+}
+
+// Helper function to cleanup the monitors for the namespace and report on the results.
+func (cmd *command) execute(client *api.Client, routeClient routeclient.RoutesGetter) error {
+	routes, err := cmd.routes(routeClient)
+	if err != nil {
+		return err
+	}
+
+	metrics.RoutesDiscovered.WithLabelValues(cmd.Namespace).Set(float64(len(routes)))
+
+	rpt := report.New()
+
+	err = cmd.syncSynthetics(client, routes, rpt)
+	if err != nil {
+		return err
+	}
+
+	err = rpt.Summary(os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Report.Write(rpt, "cleanup")
+	if err != nil {
+		return err
+	}
+
+	metrics.Report("cleanup", rpt)
+
+	err = rpt.Err()
+	if err != nil {
+		return err
+	}
+
+	metrics.Success("cleanup")
+
+	return nil
 }
 
 // Command which executes a command for an environment.
@@ -98,12 +433,22 @@ func Command(app *kingpin.Application) {
 
 	command := app.Command("cleanup", "Cleanup New Relic Synthetics monitors if OpenShift Routes do not exist").Action(c.run)
 
-	command.Flag("new-relic-api-key", "API key for authenticating with New Relic").Envar("NEW_RELIC_API_KEY").Required().StringVar(&c.NewRelicAPIKey)
+	c.NewRelicAccount.Flags(command)
+	c.NewRelicLimits.Flags(command)
 
 	command.Flag("kubernetes-master-url", "URL of the Kubernetes master").Envar("KUBERNETES_MASTER_URL").StringVar(&c.KubernetesMasterURL)
 	command.Flag("kubernetes-config", "Path to the Kubernetes config file").Envar("KUBERNETES_CONFIG").StringVar(&c.KubernetesConfig)
 
 	command.Flag("dry-run", "Print out information which would have been executed").Envar("DRY_RUN").BoolVar(&c.DryRun)
+	command.Flag("concurrency", "Number of monitors which are cleaned up at the same time").Envar("CONCURRENCY").Default("10").IntVar(&c.Concurrency)
+	c.Report.Flags(command)
+	command.Flag("metrics-addr", "Address to serve Prometheus metrics on eg. :9090").Envar("METRICS_ADDR").StringVar(&c.MetricsAddr)
+	command.Flag("interval", "Run continuously on this interval instead of once eg. 5m").Envar("INTERVAL").DurationVar(&c.Interval)
+	c.Election.Flags(command)
+	c.Claims.Flags(command)
+	c.Tombstones.Flags(command)
+	c.Safety.Flags(command)
+	command.Flag("routes-file", "Load Routes from a manifest, directory of manifests or - for stdin instead of the cluster eg. oc get routes -o yaml").Envar("ROUTES_FILE").StringVar(&c.RoutesFile)
 
 	command.Arg("namespace", "").Required().StringVar(&c.Namespace)
 }
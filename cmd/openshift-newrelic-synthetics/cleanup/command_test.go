@@ -0,0 +1,461 @@
+package cleanup
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kubefake "github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/fake"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/fake"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/safety"
+)
+
+const namespace = "test"
+
+func newClient(t *testing.T, server *fake.Server) *api.Client {
+	client, err := api.New(api.Limits{Retries: 2, Backoff: time.Millisecond}, server.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// Helper function to seed a monitor which is tagged with the Route it belongs to.
+func addMonitor(server *fake.Server, namespace, name string, tags ...entities.Tag) string {
+	tags = append(tags,
+		entities.Tag{Key: entityutils.TagOpenShiftRouteNamespace, Values: []string{namespace}},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteName, Values: []string{name}},
+	)
+
+	return server.AddMonitor(synthetics.Monitor{
+		Name:   "https://" + name + ".example.com",
+		Type:   synthetics.MonitorTypes.Ping,
+		Status: synthetics.MonitorStatus.Enabled,
+	}, tags...)
+}
+
+func newRoute(name string) *routev1.Route {
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: routev1.RouteSpec{
+			Host: name + ".example.com",
+		},
+	}
+}
+
+func TestCleanupDeletesOrphans(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, namespace, "kept")
+	orphan := addMonitor(server, namespace, "orphan")
+	addMonitor(server, "other", "elsewhere")
+
+	kube := kubefake.New(newRoute("kept"))
+
+	cmd := &command{
+		Concurrency: 2,
+		Namespace:   namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitors := server.Monitors()
+
+	if len(monitors) != 2 {
+		t.Fatalf("expected 2 monitors to remain, got %d", len(monitors))
+	}
+
+	for _, monitor := range monitors {
+		if monitor.ID == orphan {
+			t.Errorf("expected monitor %s to be deleted", orphan)
+		}
+	}
+}
+
+func TestCleanupReportsFailures(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, namespace, "orphan")
+
+	server.Fail(fake.OperationDeleteMonitor, http.StatusBadRequest, 1)
+
+	kube := kubefake.New()
+
+	cmd := &command{
+		Concurrency: 2,
+		Safety:      safety.Limits{Force: true},
+		Namespace:   namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+
+	var rerr *report.Error
+	if !errors.As(err, &rerr) {
+		t.Fatalf("expected a report error, got %v", err)
+	}
+
+	if rerr.ExitCode() != report.ExitCodeFailed {
+		t.Errorf("expected exit code %d, got %d", report.ExitCodeFailed, rerr.ExitCode())
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 1 {
+		t.Errorf("expected the monitor to remain, got %d monitors", len(monitors))
+	}
+}
+
+func TestCleanupDryRun(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, namespace, "orphan")
+
+	kube := kubefake.New()
+
+	cmd := &command{
+		DryRun:      true,
+		Concurrency: 2,
+		Safety:      safety.Limits{Force: true},
+		Namespace:   namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 1 {
+		t.Errorf("expected the monitor to remain, got %d monitors", len(monitors))
+	}
+
+	if requests := server.Requests(fake.OperationDeleteMonitor); requests != 0 {
+		t.Errorf("expected no delete requests, got %d", requests)
+	}
+}
+
+func TestCleanupRefusesWithoutRoutes(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, namespace, "orphan")
+
+	kube := kubefake.New()
+
+	cmd := &command{
+		Concurrency: 2,
+		Namespace:   namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 1 {
+		t.Errorf("expected the monitor to remain, got %d monitors", len(monitors))
+	}
+}
+
+func TestCleanupRejectsStdinOnInterval(t *testing.T) {
+	cmd := &command{
+		RoutesFile: routeutils.Stdin,
+		Interval:   time.Minute,
+	}
+
+	if err := cmd.run(nil); err == nil {
+		t.Fatal("expected stdin to be rejected when running on an interval")
+	}
+}
+
+func TestCleanupMaxDeletes(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits safety.Limits
+	}{
+		{name: "count", limits: safety.Limits{MaxDeletes: 1}},
+		{name: "percent", limits: safety.Limits{MaxDeletesPercent: 10}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := fake.New(1)
+			defer server.Close()
+
+			// Enough monitors for the percentage limit to apply.
+			var routes []runtime.Object
+
+			for i := 0; i < 8; i++ {
+				name := fmt.Sprintf("kept-%d", i)
+				addMonitor(server, namespace, name)
+				routes = append(routes, newRoute(name))
+			}
+
+			addMonitor(server, namespace, "one")
+			addMonitor(server, namespace, "two")
+
+			kube := kubefake.New(routes...)
+
+			cmd := &command{
+				Concurrency: 2,
+				Safety:      test.limits,
+				Namespace:   namespace,
+			}
+
+			err := cmd.execute(newClient(t, server), kube.Route)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			if requests := server.Requests(fake.OperationDeleteMonitor); requests != 0 {
+				t.Errorf("expected no delete requests, got %d", requests)
+			}
+		})
+	}
+}
+
+func TestCleanupSmallNamespace(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, namespace, "kept")
+	addMonitor(server, namespace, "gone")
+
+	kube := kubefake.New(newRoute("kept"))
+
+	// Removing 1 of 2 monitors is 50%, but the namespace is too small for the percentage to apply.
+	cmd := &command{
+		Concurrency: 2,
+		Safety:      safety.Limits{MaxDeletes: 50, MaxDeletesPercent: 25},
+		Namespace:   namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := server.Monitor("https://gone.example.com"); ok {
+		t.Error("expected the orphaned monitor to be deleted")
+	}
+}
+
+func TestCleanupDoNotDelete(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, namespace, "kept")
+	addMonitor(server, namespace, "protected", entities.Tag{Key: entityutils.TagDoNotDelete, Values: []string{"true"}})
+	addMonitor(server, namespace, "alias", entities.Tag{Key: entityutils.TagDoNotDeleteAlias, Values: []string{"true"}})
+	addMonitor(server, namespace, "unprotected", entities.Tag{Key: entityutils.TagDoNotDelete, Values: []string{"false"}})
+
+	kube := kubefake.New(newRoute("kept"))
+
+	cmd := &command{
+		Concurrency: 2,
+		Namespace:   namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 3 {
+		t.Errorf("expected the protected monitors to remain, got %d monitors", len(monitors))
+	}
+
+	if _, ok := server.Monitor("https://unprotected.example.com"); ok {
+		t.Error("expected the monitor tagged with false to be deleted")
+	}
+}
+
+func TestCleanupTombstones(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, namespace, "kept")
+	orphan := addMonitor(server, namespace, "orphan")
+	expired := addMonitor(server, namespace, "expired", entities.Tag{
+		Key:    entityutils.TagTombstone,
+		Values: []string{time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339)},
+	})
+
+	kube := kubefake.New(newRoute("kept"))
+
+	cmd := &command{
+		Concurrency: 2,
+		Tombstones:  entityutils.Tombstones{GracePeriod: time.Hour},
+		Namespace:   namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, ok := server.Monitor("https://orphan.example.com")
+	if !ok {
+		t.Fatal("expected the orphaned monitor to be disabled rather than deleted")
+	}
+
+	if monitor.Status != synthetics.MonitorStatus.Disabled {
+		t.Errorf("expected the orphaned monitor to be disabled, got %s", monitor.Status)
+	}
+
+	if tombstone := server.Tags(orphan)[entityutils.TagTombstone]; len(tombstone) != 1 {
+		t.Errorf("expected a tombstone tag, got %v", tombstone)
+	}
+
+	if _, ok := server.Monitor("https://expired.example.com"); ok {
+		t.Errorf("expected monitor %s to be deleted after the grace period", expired)
+	}
+
+	// The orphaned monitor is not deleted until the grace period has passed.
+	err = cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := server.Monitor("https://orphan.example.com"); !ok {
+		t.Error("expected the orphaned monitor to remain during the grace period")
+	}
+}
+
+func TestCleanupCascades(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	condition := server.AddCondition(10, alerts.SyntheticsCondition{Name: "https://orphan.example.com"})
+
+	addMonitor(server, namespace, "kept")
+	addMonitor(server, namespace, "orphan", entities.Tag{
+		Key:    entityutils.TagInventory,
+		Values: []string{fmt.Sprintf("alertCondition:10/%d", condition)},
+	})
+
+	kube := kubefake.New(newRoute("kept"))
+
+	cmd := &command{
+		Concurrency: 2,
+		Namespace:   namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if conditions := server.Conditions(10); len(conditions) != 0 {
+		t.Errorf("expected the alert condition to be deleted, got %d", len(conditions))
+	}
+
+	if _, ok := server.Monitor("https://orphan.example.com"); ok {
+		t.Error("expected the monitor to be deleted")
+	}
+}
+
+func TestCleanupCascadeFailure(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	condition := server.AddCondition(10, alerts.SyntheticsCondition{Name: "https://orphan.example.com"})
+
+	addMonitor(server, namespace, "kept")
+	addMonitor(server, namespace, "orphan", entities.Tag{
+		Key:    entityutils.TagInventory,
+		Values: []string{fmt.Sprintf("alertCondition:%d", condition)},
+	})
+
+	server.Fail(fake.OperationDeleteCondition, http.StatusBadRequest, 1)
+
+	kube := kubefake.New(newRoute("kept"))
+
+	cmd := &command{
+		Concurrency: 2,
+		Namespace:   namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+
+	var rerr *report.Error
+	if !errors.As(err, &rerr) {
+		t.Fatalf("expected a report error, got %v", err)
+	}
+
+	if _, ok := server.Monitor("https://orphan.example.com"); !ok {
+		t.Error("expected the monitor to remain until its alert condition is deleted")
+	}
+
+	if requests := server.Requests(fake.OperationDeleteMonitor); requests != 0 {
+		t.Errorf("expected no delete requests, got %d", requests)
+	}
+}
+
+func TestCleanupReleasesSharedMonitors(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, namespace, "kept")
+
+	shared := server.AddMonitor(synthetics.Monitor{
+		Name:   "https://web.example.com",
+		Type:   synthetics.MonitorTypes.Ping,
+		Status: synthetics.MonitorStatus.Enabled,
+	},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteNamespace, Values: []string{namespace, "other"}},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteName, Values: []string{"web", "shard"}},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteOwner, Values: []string{"other/shard", namespace + "/web"}},
+		entities.Tag{Key: "team", Values: []string{"platform"}},
+	)
+
+	kube := kubefake.New(newRoute("kept"))
+
+	cmd := &command{
+		Concurrency: 2,
+		Namespace:   namespace,
+	}
+
+	err := cmd.execute(newClient(t, server), kube.Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := server.Requests(fake.OperationDeleteMonitor); requests != 0 {
+		t.Fatalf("expected no monitors to be deleted, got %d", requests)
+	}
+
+	tags := server.Tags(shared)
+
+	if got := tags[entityutils.TagOpenShiftRouteOwner]; len(got) != 1 || got[0] != "other/shard" {
+		t.Errorf("expected only the owner from the other namespace to remain, got %v", got)
+	}
+
+	if got := tags[entityutils.TagOpenShiftRouteNamespace]; len(got) != 1 || got[0] != "other" {
+		t.Errorf("expected only the other namespace to remain, got %v", got)
+	}
+
+	if got := tags["team"]; len(got) != 1 {
+		t.Errorf("expected unmanaged tags to be kept, got %v", got)
+	}
+}
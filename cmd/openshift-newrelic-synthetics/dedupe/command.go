@@ -0,0 +1,149 @@
+package dedupe
+
+import (
+	"os"
+
+	routeclient "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/alecthomas/kingpin.v2"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/dedupe"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/metrics"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/account"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+)
+
+type command struct {
+	NewRelicAccount     account.Account
+	NewRelicLimits      api.Limits
+	KubernetesMasterURL string
+	KubernetesConfig    string
+	DryRun              bool
+	Rule                string
+	Action              string
+	Report              report.Output
+	Namespace           string
+}
+
+func (cmd *command) run(c *kingpin.ParseContext) error {
+	config, err := clientcmd.BuildConfigFromFlags(cmd.KubernetesMasterURL, cmd.KubernetesConfig)
+	if err != nil {
+		return err
+	}
+
+	routeClient, err := routeclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	coreClient, err := coreclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	acc, err := account.Resolve(coreClient, cmd.Namespace, cmd.NewRelicAccount)
+	if err != nil {
+		return err
+	}
+
+	client, err := acc.Client(cmd.NewRelicLimits)
+	if err != nil {
+		return err
+	}
+
+	defer client.LogCalls()
+
+	return cmd.execute(client, routeClient)
+}
+
+// Helper function to repair the duplicate monitors in the namespace and report on the results.
+func (cmd *command) execute(client *api.Client, routeClient routeclient.RoutesGetter) error {
+	routes, err := routeutils.List(routeClient, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]bool, len(routes))
+
+	for _, route := range routes {
+		uri := routeutils.URL(route)
+		current[uri.String()] = true
+	}
+
+	monitors, err := monitorutils.List(client)
+	if err != nil {
+		return err
+	}
+
+	list, err := entityutils.Search(client, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	resources, err := entityutils.SearchTag(client, entityutils.TagSyntheticMonitorNamespace, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	groups := dedupe.Find(monitors, append(list, resources...))
+
+	metrics.Duplicates.WithLabelValues(cmd.Namespace).Set(float64(len(groups)))
+
+	if len(groups) == 0 {
+		log.Infoln("No duplicate monitors were found")
+		return nil
+	}
+
+	rpt := report.New()
+
+	for _, group := range groups {
+		log.WithField("key", group.Key).Infof("Found %d duplicate monitors", len(group.Members))
+
+		dedupe.Repair(client, rpt, group, dedupe.Options{
+			Rule:    dedupe.Rule(cmd.Rule),
+			Action:  dedupe.Action(cmd.Action),
+			DryRun:  cmd.DryRun,
+			Current: current,
+		})
+	}
+
+	err = rpt.Summary(os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Report.Write(rpt, "dedupe")
+	if err != nil {
+		return err
+	}
+
+	metrics.Report("dedupe", rpt)
+
+	return rpt.Err()
+}
+
+// Command which repairs duplicate monitors.
+func Command(app *kingpin.Application) {
+	c := new(command)
+
+	command := app.Command("dedupe", "Find managed monitors which duplicate each other and remove the extras").Action(c.run)
+
+	c.NewRelicAccount.Flags(command)
+	c.NewRelicLimits.Flags(command)
+
+	command.Flag("kubernetes-master-url", "URL of the Kubernetes master").Envar("KUBERNETES_MASTER_URL").StringVar(&c.KubernetesMasterURL)
+	command.Flag("kubernetes-config", "Path to the Kubernetes config file").Envar("KUBERNETES_CONFIG").StringVar(&c.KubernetesConfig)
+
+	command.Flag("dry-run", "Print out information which would have been executed").Envar("DRY_RUN").BoolVar(&c.DryRun)
+	command.Flag("rule", "Rule which picks the monitor which survives").Envar("DEDUPE_RULE").Default(string(dedupe.RuleOldest)).EnumVar(&c.Rule, dedupe.Rules...)
+	command.Flag("action", "Action taken for the extra monitors").Envar("DEDUPE_ACTION").Default(string(dedupe.ActionDelete)).EnumVar(&c.Action, dedupe.Actions...)
+	c.Report.Flags(command)
+
+	command.Arg("namespace", "Namespace where Routes will be queried").Required().StringVar(&c.Namespace)
+}
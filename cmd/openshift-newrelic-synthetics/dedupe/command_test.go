@@ -0,0 +1,275 @@
+package dedupe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/dedupe"
+	kubefake "github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/fake"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/fake"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/inventory"
+)
+
+const namespace = "test"
+
+func newClient(t *testing.T, server *fake.Server) *api.Client {
+	client, err := api.New(api.Limits{Retries: 2, Backoff: time.Millisecond}, server.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func newRoute(name string) *routev1.Route {
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: routev1.RouteSpec{
+			Host: name + ".example.com",
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: name,
+			},
+			TLS: &routev1.TLSConfig{},
+		},
+	}
+}
+
+// Helper function to seed a managed monitor for a Route, which was created some time ago, along with an alert condition in a policy.
+func addMonitor(server *fake.Server, name string, age time.Duration, policyID int, tags ...entities.Tag) (string, int) {
+	created := synthetics.Time(time.Now().Add(-age))
+
+	id := server.AddMonitor(synthetics.Monitor{
+		Name:       "https://" + name + ".example.com",
+		Type:       synthetics.MonitorTypes.Ping,
+		URI:        "https://" + name + ".example.com",
+		Status:     synthetics.MonitorStatus.Enabled,
+		CreatedAt:  &created,
+		ModifiedAt: &created,
+	})
+
+	condition := server.AddCondition(policyID, alerts.SyntheticsCondition{
+		Name:      "https://" + name + ".example.com",
+		MonitorID: id,
+		Enabled:   true,
+	})
+
+	tags = append(tags,
+		entities.Tag{Key: entityutils.TagOpenShiftRouteNamespace, Values: []string{namespace}},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteName, Values: []string{name}},
+	)
+	tags = append(tags, inventory.Tags([]inventory.Item{inventory.AlertCondition(policyID, condition)})...)
+
+	server.AddTags(id, tags...)
+
+	return id, condition
+}
+
+func TestDedupe(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	oldest, _ := addMonitor(server, "web", time.Hour, 10)
+	newest, condition := addMonitor(server, "web", time.Minute, 20, entities.Tag{Key: "team", Values: []string{"platform"}})
+
+	cmd := &command{
+		Namespace: namespace,
+		Rule:      string(dedupe.RuleOldest),
+		Action:    string(dedupe.ActionDelete),
+	}
+
+	err := cmd.execute(newClient(t, server), kubefake.New(newRoute("web")).Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, ok := server.Monitor("https://web.example.com")
+	if !ok || monitor.ID != oldest {
+		t.Fatalf("expected %s to survive, got %+v", oldest, server.Monitors())
+	}
+
+	for _, existing := range server.Monitors() {
+		if existing.ID == newest {
+			t.Errorf("expected duplicate %s to be deleted", newest)
+		}
+	}
+
+	conditions := server.Conditions(20)
+	if len(conditions) != 1 || conditions[0].MonitorID != oldest {
+		t.Errorf("expected condition %d to be migrated to %s, got %+v", condition, oldest, conditions)
+	}
+
+	tags := server.Tags(oldest)
+
+	want := inventory.AlertCondition(20, condition).String()
+	if !contains(tags[entityutils.TagInventory], want) {
+		t.Errorf("expected inventory %s on survivor, got %v", want, tags[entityutils.TagInventory])
+	}
+
+	if got := tags["team"]; len(got) != 1 || got[0] != "platform" {
+		t.Errorf("expected unmanaged tag to be copied to survivor, got %v", got)
+	}
+}
+
+func TestDedupeNewest(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	oldest, _ := addMonitor(server, "web", time.Hour, 10)
+	newest, _ := addMonitor(server, "web", time.Minute, 10)
+
+	cmd := &command{
+		Namespace: namespace,
+		Rule:      string(dedupe.RuleNewest),
+		Action:    string(dedupe.ActionDelete),
+	}
+
+	err := cmd.execute(newClient(t, server), kubefake.New(newRoute("web")).Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, ok := server.Monitor("https://web.example.com")
+	if !ok || monitor.ID != newest {
+		t.Fatalf("expected %s to survive, got %+v", newest, server.Monitors())
+	}
+
+	// Both monitors were in the same policy, so the condition of the extra goes with it.
+	for _, condition := range server.Conditions(10) {
+		if condition.MonitorID == oldest {
+			t.Errorf("expected condition %d of %s to be deleted", condition.ID, oldest)
+		}
+	}
+}
+
+func TestDedupeDisable(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	oldest, _ := addMonitor(server, "web", time.Hour, 10)
+	newest, _ := addMonitor(server, "web", time.Minute, 20)
+
+	cmd := &command{
+		Namespace: namespace,
+		Rule:      string(dedupe.RuleOldest),
+		Action:    string(dedupe.ActionDisable),
+	}
+
+	err := cmd.execute(newClient(t, server), kubefake.New(newRoute("web")).Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := fmt.Sprintf("https://web.example.com (duplicate of %s)", oldest)
+
+	monitor, ok := server.Monitor(name)
+	if !ok || monitor.ID != newest {
+		t.Fatalf("expected %s to be renamed to %q, got %+v", newest, name, server.Monitors())
+	}
+
+	if monitor.Status != synthetics.MonitorStatus.Disabled {
+		t.Errorf("expected duplicate to be disabled, got %s", monitor.Status)
+	}
+
+	tags := server.Tags(newest)
+
+	if _, ok := tags[entityutils.TagOpenShiftRouteName]; ok {
+		t.Errorf("expected managed tags to be removed from duplicate, got %v", tags)
+	}
+
+	if _, ok := tags[entityutils.TagInventory]; ok {
+		t.Errorf("expected migrated inventory to be removed from duplicate, got %v", tags)
+	}
+}
+
+func TestDedupeDryRun(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, "web", time.Hour, 10)
+	addMonitor(server, "web", time.Minute, 20)
+
+	before := len(server.Monitors())
+
+	cmd := &command{
+		Namespace: namespace,
+		Rule:      string(dedupe.RuleOldest),
+		Action:    string(dedupe.ActionDelete),
+		DryRun:    true,
+	}
+
+	err := cmd.execute(newClient(t, server), kubefake.New(newRoute("web")).Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(server.Monitors()); got != before {
+		t.Errorf("expected %d monitors, got %d", before, got)
+	}
+
+	for _, operation := range []string{fake.OperationDeleteMonitor, fake.OperationUpdateCondition, fake.OperationDeleteCondition, fake.OperationAddTags} {
+		if got := server.Requests(operation); got != 0 {
+			t.Errorf("expected no %s requests, got %d", operation, got)
+		}
+	}
+}
+
+func TestDedupeKeepsOwnersSharingURL(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	for _, name := range []string{"home", "login"} {
+		id := server.AddMonitor(synthetics.Monitor{
+			Name:   "https://web.example.com (" + name + ")",
+			Type:   synthetics.MonitorTypes.Ping,
+			URI:    "https://web.example.com",
+			Status: synthetics.MonitorStatus.Enabled,
+		})
+
+		server.AddTags(id,
+			entities.Tag{Key: entityutils.TagSyntheticMonitorNamespace, Values: []string{namespace}},
+			entities.Tag{Key: entityutils.TagSyntheticMonitorName, Values: []string{name}},
+		)
+	}
+
+	cmd := &command{
+		Namespace: namespace,
+		Rule:      string(dedupe.RuleOldest),
+		Action:    string(dedupe.ActionDelete),
+	}
+
+	err := cmd.execute(newClient(t, server), kubefake.New(newRoute("web")).Route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(server.Monitors()); got != 2 {
+		t.Errorf("expected both monitors to survive, got %d", got)
+	}
+
+	if got := server.Requests(fake.OperationDeleteMonitor); got != 0 {
+		t.Errorf("expected no monitors to be deleted, got %d requests", got)
+	}
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,296 @@
+package drift
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	routeclient "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/client-go/dynamic"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/drift"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/metrics"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/account"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/settings"
+)
+
+type command struct {
+	NewRelicAccount     account.Account
+	NewRelicLocation    string
+	NewRelicLimits      api.Limits
+	KubernetesMasterURL string
+	KubernetesConfig    string
+	DryRun              bool
+	Drift               drift.Options
+	Report              report.Output
+	Namespace           string
+}
+
+func (cmd *command) run(c *kingpin.ParseContext) error {
+	config, err := clientcmd.BuildConfigFromFlags(cmd.KubernetesMasterURL, cmd.KubernetesConfig)
+	if err != nil {
+		return err
+	}
+
+	routeClient, err := routeclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	coreClient, err := coreclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	policies, namespaceLabels, err := settings.Load(dynamicClient, coreClient, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	acc, err := account.Resolve(coreClient, cmd.Namespace, cmd.NewRelicAccount)
+	if err != nil {
+		return err
+	}
+
+	client, err := acc.Client(cmd.NewRelicLimits)
+	if err != nil {
+		return err
+	}
+
+	defer client.LogCalls()
+
+	return cmd.execute(client, routeClient, policies, namespaceLabels)
+}
+
+// Helper function to compare the monitors for the namespace to the desired state from their Routes and report on the results.
+func (cmd *command) execute(client *api.Client, routeClient routeclient.RoutesGetter, policies []v1alpha1.SyntheticsPolicy, namespaceLabels map[string]string) error {
+	routes, err := routeutils.List(routeClient, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	monitors, err := monitorutils.List(client)
+	if err != nil {
+		return err
+	}
+
+	groups := routeutils.Group(routes)
+
+	urls := make([]string, 0, len(groups))
+	ids := make(map[string]string, len(groups))
+
+	for urlString := range groups {
+		urls = append(urls, urlString)
+
+		if id, ok := monitorutils.Exists(monitors, urlString); ok {
+			ids[urlString] = id
+		}
+	}
+
+	sort.Strings(urls)
+
+	existing := make(map[string]*entityutils.Entity, len(ids))
+
+	if len(ids) > 0 {
+		list, err := entityutils.Lookup(client, entityutils.TagOpenShiftRouteNamespace, cmd.Namespace, ids)
+		if err != nil {
+			return fmt.Errorf("failed to lookup monitor tags: %w", err)
+		}
+
+		for _, entity := range list {
+			existing[entity.Name] = entity
+		}
+	}
+
+	rpt := report.New()
+
+	var edited int
+
+	for _, urlString := range urls {
+		route := groups[urlString][0]
+
+		logger := log.WithFields(log.Fields{
+			"namespace": route.ObjectMeta.Namespace,
+			"name":      route.ObjectMeta.Name,
+			"url":       urlString,
+		})
+
+		result := &report.Result{
+			Namespace: route.ObjectMeta.Namespace,
+			Route:     route.ObjectMeta.Name,
+			URL:       urlString,
+			Monitor:   urlString,
+			Action:    report.ActionSkipped,
+		}
+
+		rpt.Add(result)
+
+		effective, err := settings.Resolve(settings.Defaults(cmd.NewRelicLocation), policies, namespaceLabels, route)
+		if err != nil {
+			result.Action = report.ActionFailed
+			result.Error = fmt.Errorf("failed to resolve monitor settings: %w", err)
+			continue
+		}
+
+		if !effective.Enabled {
+			result.Reason = fmt.Sprintf("monitoring is disabled by %s", effective.Sources[settings.FieldEnabled])
+			continue
+		}
+
+		live, ok := monitorutils.Find(monitors, urlString)
+		if !ok {
+			result.Reason = "monitor does not exist"
+			continue
+		}
+
+		result.MonitorID = live.ID
+
+		var tags []*entities.Tag
+
+		entity, ok := existing[urlString]
+		if ok {
+			tags = entity.Tags
+			result.GUID = entity.GUID
+			result.Permalink = entity.Permalink
+		}
+
+		if _, ok := entityutils.GetTombstone(tags); ok {
+			result.Reason = "monitor is disabled ahead of being deleted"
+			continue
+		}
+
+		desired := monitorutils.Merge(*live, effective.Monitor(urlString), effective.ManagedFields)
+
+		differences, accepted := drift.Resolve(&desired, *live, drift.Accepted(tags), drift.Policy(cmd.Drift.Policy))
+		if len(differences) == 0 {
+			result.Reason = "monitor has not drifted"
+			continue
+		}
+
+		edited++
+
+		result.Drift = differences
+
+		logger.WithField("drift", drift.Summary(differences)).Warnln("Monitor has drifted from its desired state")
+
+		switch drift.Policy(cmd.Drift.Policy) {
+		case drift.PolicyRevert:
+			cmd.revert(client, result, *live, desired)
+		case drift.PolicyAccept:
+			cmd.accept(client, result, entity, accepted)
+		default:
+			result.Action = report.ActionDrifted
+			result.Reason = fmt.Sprintf("monitor has drifted: %s", drift.Summary(differences))
+		}
+	}
+
+	metrics.Drift.WithLabelValues("fields").Set(float64(edited))
+
+	err = drift.Print(os.Stdout, rpt.Results())
+	if err != nil {
+		return err
+	}
+
+	err = rpt.Summary(os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Report.Write(rpt, "drift")
+	if err != nil {
+		return err
+	}
+
+	metrics.Report("drift", rpt)
+
+	return rpt.Err()
+}
+
+// Helper function to update a monitor back to its desired state.
+func (cmd *command) revert(client *api.Client, result *report.Result, live, desired synthetics.Monitor) {
+	result.Reason = fmt.Sprintf("reverted drift: %s", drift.Summary(result.Drift))
+
+	if cmd.DryRun {
+		result.Action = report.ActionDryRun
+		return
+	}
+
+	desired.ID = live.ID
+
+	err := client.Call("Synthetics.UpdateMonitor", func() error {
+		_, err := client.Synthetics.UpdateMonitor(desired)
+		return err
+	})
+	if err != nil {
+		result.Action = report.ActionFailed
+		result.Error = fmt.Errorf("failed to revert monitor: %w", err)
+		return
+	}
+
+	result.Action = report.ActionUpdated
+}
+
+// Helper function to record the accepted differences on a monitor, so they are kept by the next sync.
+func (cmd *command) accept(client *api.Client, result *report.Result, entity *entityutils.Entity, accepted []report.Difference) {
+	result.Reason = fmt.Sprintf("accepted drift: %s", drift.Summary(result.Drift))
+
+	if cmd.DryRun {
+		result.Action = report.ActionDryRun
+		return
+	}
+
+	if entity == nil {
+		result.Action = report.ActionFailed
+		result.Error = fmt.Errorf("failed to accept drift: monitor entity could not be found")
+		return
+	}
+
+	desired := entityutils.Replace(entity.Tags, drift.Tags(accepted))
+
+	_, err := entityutils.ReconcileTags(client, entity.GUID, entity.Tags, desired)
+	if err != nil {
+		result.Action = report.ActionFailed
+		result.Error = fmt.Errorf("failed to accept drift: %w", err)
+		return
+	}
+
+	result.Tags = desired
+	result.Action = report.ActionUpdated
+}
+
+// Command which reports on monitors which were edited outside of this tool.
+func Command(app *kingpin.Application) {
+	c := new(command)
+
+	command := app.Command("drift", "Compare monitors to the desired state from their Routes and report, revert or accept the differences").Action(c.run)
+
+	c.NewRelicAccount.Flags(command)
+	command.Flag("new-relic-location", "Location which monitors will be provisioned").Default("AWS_AP_SOUTHEAST_2").StringVar(&c.NewRelicLocation)
+	c.NewRelicLimits.Flags(command)
+
+	command.Flag("kubernetes-master-url", "URL of the Kubernetes master").Envar("KUBERNETES_MASTER_URL").StringVar(&c.KubernetesMasterURL)
+	command.Flag("kubernetes-config", "Path to the Kubernetes config file").Envar("KUBERNETES_CONFIG").StringVar(&c.KubernetesConfig)
+
+	command.Flag("dry-run", "Print out information which would have been executed").Envar("DRY_RUN").BoolVar(&c.DryRun)
+	c.Drift.Flags(command, drift.PolicyReport)
+	c.Report.Flags(command)
+
+	command.Arg("namespace", "Namespace where Routes will be queried").Required().StringVar(&c.Namespace)
+}
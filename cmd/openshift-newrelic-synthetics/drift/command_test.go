@@ -0,0 +1,165 @@
+package drift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/drift"
+	kubefake "github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/fake"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/fake"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+)
+
+const (
+	namespace = "test"
+	location  = "AWS_AP_SOUTHEAST_2"
+)
+
+func newClient(t *testing.T, server *fake.Server) *api.Client {
+	client, err := api.New(api.Limits{Retries: 2, Backoff: time.Millisecond}, server.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func newRoute(name string) *routev1.Route {
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: routev1.RouteSpec{
+			Host: name + ".example.com",
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: name,
+			},
+			TLS: &routev1.TLSConfig{},
+		},
+	}
+}
+
+// Helper function to seed a managed monitor which was edited to run every 15 minutes.
+func addEditedMonitor(server *fake.Server, tags ...entities.Tag) string {
+	tags = append(tags, entityutils.RouteTags(*newRoute("web"))...)
+
+	return server.AddMonitor(synthetics.Monitor{
+		Name:         "https://web.example.com",
+		Type:         monitorutils.DefaultType,
+		Frequency:    15,
+		URI:          "https://web.example.com",
+		Locations:    []string{location},
+		Status:       monitorutils.DefaultStatus,
+		SLAThreshold: monitorutils.DefaultSLAThreshold,
+	}, tags...)
+}
+
+func newCommand(policy drift.Policy) *command {
+	return &command{
+		NewRelicLocation: location,
+		Drift:            drift.Options{Policy: string(policy)},
+		Namespace:        namespace,
+	}
+}
+
+func TestDriftReports(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addEditedMonitor(server)
+
+	err := newCommand(drift.PolicyReport).execute(newClient(t, server), kubefake.New(newRoute("web")).Route, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := server.Requests(fake.OperationUpdateMonitor); requests != 0 {
+		t.Errorf("expected no monitors to be updated, got %d", requests)
+	}
+
+	monitor, _ := server.Monitor("https://web.example.com")
+	if monitor.Frequency != 15 {
+		t.Errorf("expected frequency to be left at 15, got %d", monitor.Frequency)
+	}
+}
+
+func TestDriftReverts(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addEditedMonitor(server)
+
+	err := newCommand(drift.PolicyRevert).execute(newClient(t, server), kubefake.New(newRoute("web")).Route, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, _ := server.Monitor("https://web.example.com")
+	if monitor.Frequency != monitorutils.DefaultFrequency {
+		t.Errorf("expected frequency to be reverted to %d, got %d", monitorutils.DefaultFrequency, monitor.Frequency)
+	}
+}
+
+func TestDriftAccepts(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	id := addEditedMonitor(server)
+
+	kube := kubefake.New(newRoute("web"))
+
+	err := newCommand(drift.PolicyAccept).execute(newClient(t, server), kube.Route, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := server.Tags(id)[entityutils.TagDriftAccepted]; len(got) != 1 || got[0] != "frequency=1" {
+		t.Fatalf("expected accepted frequency to be recorded, got %v", got)
+	}
+
+	// Accepted edits are no longer drift, so they are kept when reverting.
+	err = newCommand(drift.PolicyRevert).execute(newClient(t, server), kube.Route, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, _ := server.Monitor("https://web.example.com")
+	if monitor.Frequency != 15 {
+		t.Errorf("expected accepted frequency to be kept, got %d", monitor.Frequency)
+	}
+
+	if requests := server.Requests(fake.OperationUpdateMonitor); requests != 0 {
+		t.Errorf("expected no monitors to be updated, got %d", requests)
+	}
+}
+
+func TestDriftIgnoresUnmanagedFields(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addEditedMonitor(server)
+
+	route := newRoute("web")
+	route.ObjectMeta.Annotations = map[string]string{
+		routeutils.AnnotationManagedFields: "type,locations",
+	}
+
+	err := newCommand(drift.PolicyRevert).execute(newClient(t, server), kubefake.New(route).Route, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := server.Requests(fake.OperationUpdateMonitor); requests != 0 {
+		t.Errorf("expected no monitors to be updated, got %d", requests)
+	}
+}
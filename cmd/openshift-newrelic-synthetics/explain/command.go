@@ -0,0 +1,100 @@
+package explain
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	routeclient "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/client-go/dynamic"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/settings"
+)
+
+type command struct {
+	NewRelicLocation    string
+	KubernetesMasterURL string
+	KubernetesConfig    string
+	Namespace           string
+	Route               string
+}
+
+func (cmd *command) run(c *kingpin.ParseContext) error {
+	config, err := clientcmd.BuildConfigFromFlags(cmd.KubernetesMasterURL, cmd.KubernetesConfig)
+	if err != nil {
+		return err
+	}
+
+	routeClient, err := routeclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	coreClient, err := coreclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	route, err := routeutils.Get(routeClient, cmd.Namespace, cmd.Route)
+	if err != nil {
+		return err
+	}
+
+	policies, namespaceLabels, err := settings.Load(dynamicClient, coreClient, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	effective, err := settings.Resolve(settings.Defaults(cmd.NewRelicLocation), policies, namespaceLabels, *route)
+
+	uri := routeutils.URL(*route)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Route:\t%s/%s\n", route.ObjectMeta.Namespace, route.ObjectMeta.Name)
+	fmt.Fprintf(tw, "URL:\t%s\n", uri.String())
+	fmt.Fprintf(tw, "Policies:\t%s\n", strings.Join(effective.Policies, ", "))
+
+	if _, ok := route.ObjectMeta.Annotations[routeutils.AnnotationIPWhitelist]; ok {
+		fmt.Fprintf(tw, "Skipped:\tannotation is set: %s\n", routeutils.AnnotationIPWhitelist)
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "SETTING\tVALUE\tSOURCE")
+
+	for _, field := range settings.Fields {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", field, effective.Value(field), effective.Sources[field])
+	}
+
+	if flushErr := tw.Flush(); flushErr != nil {
+		return flushErr
+	}
+
+	// Settings are still printed when invalid so the source of the problem can be found.
+	return err
+}
+
+// Command which explains the settings used to monitor a Route.
+func Command(app *kingpin.Application) {
+	c := new(command)
+
+	command := app.Command("explain", "Print the effective monitor settings for a Route and where each came from").Action(c.run)
+
+	command.Flag("new-relic-location", "Location which monitors will be provisioned").Default("AWS_AP_SOUTHEAST_2").StringVar(&c.NewRelicLocation)
+
+	command.Flag("kubernetes-master-url", "URL of the Kubernetes master").Envar("KUBERNETES_MASTER_URL").StringVar(&c.KubernetesMasterURL)
+	command.Flag("kubernetes-config", "Path to the Kubernetes config file").Envar("KUBERNETES_CONFIG").StringVar(&c.KubernetesConfig)
+
+	command.Arg("namespace", "Namespace of the Route").Required().StringVar(&c.Namespace)
+	command.Arg("route", "Name of the Route").Required().StringVar(&c.Route)
+}
@@ -1,19 +1,46 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"gopkg.in/alecthomas/kingpin.v2"
 
+	"github.com/codedropau/openshift-newrelic-synthetics/cmd/openshift-newrelic-synthetics/adopt"
 	"github.com/codedropau/openshift-newrelic-synthetics/cmd/openshift-newrelic-synthetics/cleanup"
+	"github.com/codedropau/openshift-newrelic-synthetics/cmd/openshift-newrelic-synthetics/dedupe"
+	"github.com/codedropau/openshift-newrelic-synthetics/cmd/openshift-newrelic-synthetics/drift"
+	"github.com/codedropau/openshift-newrelic-synthetics/cmd/openshift-newrelic-synthetics/explain"
+	"github.com/codedropau/openshift-newrelic-synthetics/cmd/openshift-newrelic-synthetics/monitors"
 	"github.com/codedropau/openshift-newrelic-synthetics/cmd/openshift-newrelic-synthetics/sync"
+	"github.com/codedropau/openshift-newrelic-synthetics/cmd/openshift-newrelic-synthetics/webhook"
 )
 
+// Implemented by errors which require a specific exit code eg. a partial failure.
+type exitCoder interface {
+	ExitCode() int
+}
+
 func main() {
 	app := kingpin.New("openshift-newrelic-synthetics", "Bridging the gap between OpenShift and New Relic Synthetics")
 
+	adopt.Command(app)
 	cleanup.Command(app)
+	dedupe.Command(app)
+	drift.Command(app)
+	explain.Command(app)
+	monitors.Command(app)
 	sync.Command(app)
+	webhook.Command(app)
+
+	_, err := app.Parse(os.Args[1:])
+	if err != nil {
+		var coder exitCoder
+		if errors.As(err, &coder) {
+			app.Errorf("%s", err)
+			os.Exit(coder.ExitCode())
+		}
 
-	kingpin.MustParse(app.Parse(os.Args[1:]))
+		app.Fatalf("%s, try --help", err)
+	}
 }
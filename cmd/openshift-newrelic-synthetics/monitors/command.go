@@ -0,0 +1,638 @@
+package monitors
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	routeclient "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/leader"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/syntheticmonitor"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/metrics"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/account"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/condition"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/inventory"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/safety"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/schedule"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/settings"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/worker"
+)
+
+type command struct {
+	NewRelicAccount     account.Account
+	NewRelicLocation    string
+	NewRelicLimits      api.Limits
+	KubernetesMasterURL string
+	KubernetesConfig    string
+	DryRun              bool
+	Concurrency         int
+	Report              report.Output
+	MetricsAddr         string
+	Interval            time.Duration
+	Election            leader.Election
+	Safety              safety.Limits
+	Namespace           string
+}
+
+// Clients used when reconciling SyntheticMonitors.
+type clients struct {
+	newRelic *api.Client
+	routes   routeclient.RoutesGetter
+	core     coreclient.ConfigMapsGetter
+	dynamic  dynamic.Interface
+}
+
+func (cmd *command) syncMonitors(c clients, list []v1alpha1.SyntheticMonitor, rpt *report.Report) error {
+	monitors, err := monitorutils.List(c.newRelic)
+	if err != nil {
+		return err
+	}
+
+	owned, err := owners(c.newRelic, monitors, list)
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+
+	tags := make(map[string][]entities.Tag, len(list))
+	ids := make(map[string]string, len(list))
+	results := make(map[string]*report.Result, len(list))
+	resources := make(map[string]*v1alpha1.SyntheticMonitor, len(list))
+	inventories := make(map[string][]inventory.Item, len(list))
+	names := make(map[string]string, len(list))
+	claimed := make(map[string]string, len(list))
+
+	pool := worker.New(cmd.Concurrency)
+
+	for i := range list {
+		sm := &list[i]
+
+		names[sm.ObjectMeta.Name] = name(sm)
+
+		// Only the first SyntheticMonitor with a name gets the monitor, the others would take turns updating it.
+		duplicate, taken := claimed[name(sm)]
+		if !taken {
+			claimed[name(sm)] = sm.ObjectMeta.Name
+		}
+
+		pool.Go(func() error {
+			logger := log.WithFields(log.Fields{
+				"namespace": sm.ObjectMeta.Namespace,
+				"name":      sm.ObjectMeta.Name,
+			})
+
+			result := &report.Result{
+				Namespace: sm.ObjectMeta.Namespace,
+				Route:     sm.ObjectMeta.Name,
+				Monitor:   name(sm),
+			}
+
+			rpt.Add(result)
+
+			fail := func(reason string, err error) error {
+				logger.Errorln(reason, err)
+				result.Action = report.ActionFailed
+				result.Error = fmt.Errorf("%s %w", reason, err)
+				setReady(sm, metav1.ConditionFalse, "Failed", result.Error.Error())
+				return nil
+			}
+
+			if taken {
+				return fail("Refusing to create/update monitor:", fmt.Errorf("monitor %q is already declared by SyntheticMonitor %s", name(sm), duplicate))
+			}
+
+			monitor, err := cmd.desired(c.routes, sm)
+			if err != nil {
+				return fail("Failed to resolve monitor:", err)
+			}
+
+			// Monitors are matched by name across the account, so a monitor which belongs to another owner is never taken over.
+			if existing, found := monitorutils.Find(monitors, monitor.Name); found {
+				if owner := foreignOwner(owned[existing.ID], sm); owner != "" {
+					return fail("Refusing to update monitor:", fmt.Errorf("monitor %q is owned by %s", monitor.Name, owner))
+				}
+			}
+
+			result.URL = monitor.URI
+			sm.Status.URL = monitor.URI
+
+			if cmd.DryRun {
+				logger.Infoln("Dry run is enabled. A monitor would have been created or updated for this SyntheticMonitor.")
+				result.Action = report.ActionDryRun
+				return nil
+			}
+
+			logger.Infoln("Creating/Updating monitor")
+
+			m, created, err := monitorutils.CreateOrUpdate(c.newRelic, monitors, monitor, nil)
+			if err != nil {
+				return fail("Failed to create/update monitor:", err)
+			}
+
+			result.MonitorID = m.ID
+			result.Action = report.ActionUpdated
+
+			if created {
+				result.Action = report.ActionCreated
+			}
+
+			sm.Status.MonitorID = m.ID
+
+			if sm.Spec.Script != nil {
+				script, err := readScript(c.core, sm)
+				if err != nil {
+					return fail("Failed to read script:", err)
+				}
+
+				err = monitorutils.UpdateScript(c.newRelic, m.ID, script)
+				if err != nil {
+					return fail("Failed to update script:", err)
+				}
+			}
+
+			var items []inventory.Item
+
+			if sm.Spec.Alerts != nil {
+				enabled := true
+				if sm.Spec.Alerts.Enabled != nil {
+					enabled = *sm.Spec.Alerts.Enabled
+				}
+
+				cond, err := condition.Ensure(c.newRelic, sm.Spec.Alerts.PolicyID, alerts.SyntheticsCondition{
+					Name:       m.Name,
+					Enabled:    enabled,
+					RunbookURL: sm.Spec.Alerts.RunbookURL,
+					MonitorID:  m.ID,
+				})
+				if err != nil {
+					return fail("Failed to ensure alert condition:", err)
+				}
+
+				sm.Status.ConditionID = cond.ID
+
+				items = append(items, inventory.AlertCondition(sm.Spec.Alerts.PolicyID, cond.ID))
+			}
+
+			setReady(sm, metav1.ConditionTrue, "Synced", fmt.Sprintf("Monitor %s is monitoring %s", m.ID, monitor.URI))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			ids[m.Name] = m.ID
+			results[m.Name] = result
+			resources[m.Name] = sm
+			inventories[m.Name] = items
+
+			result.Tags = []entities.Tag{
+				{
+					Key:    entityutils.TagSyntheticMonitorNamespace,
+					Values: []string{sm.ObjectMeta.Namespace},
+				},
+				{
+					Key:    entityutils.TagSyntheticMonitorName,
+					Values: []string{sm.ObjectMeta.Name},
+				},
+			}
+
+			tags[m.Name] = result.Tags
+
+			return nil
+		})
+	}
+
+	err = pool.Wait()
+	if err != nil {
+		return err
+	}
+
+	if len(ids) > 0 {
+		found, err := entityutils.Lookup(c.newRelic, entityutils.TagSyntheticMonitorNamespace, cmd.Namespace, ids)
+		if err != nil {
+			return err
+		}
+
+		pool = worker.New(cmd.Concurrency)
+
+		for _, entity := range found {
+			entity := entity
+			result := results[entity.Name]
+
+			resources[entity.Name].Status.GUID = entity.GUID
+
+			pool.Go(func() error {
+				result.GUID = entity.GUID
+				result.Permalink = entity.Permalink
+
+				desired := append(tags[entity.Name], inventory.Tags(inventory.Prune(c.newRelic, entity.Tags, inventories[entity.Name]))...)
+				result.Tags = desired
+
+				_, err := entityutils.ReconcileTags(c.newRelic, entity.GUID, entity.Tags, desired)
+				if err != nil {
+					log.WithField("name", entity.Name).Errorln("Failed to reconcile tags:", err)
+					result.Action = report.ActionFailed
+					result.Error = fmt.Errorf("failed to reconcile tags: %w", err)
+				}
+
+				return nil
+			})
+		}
+
+		err = pool.Wait()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.collectGarbage(c.newRelic, names, rpt)
+	if err != nil {
+		return err
+	}
+
+	if cmd.DryRun {
+		return nil
+	}
+
+	for i := range list {
+		err := syntheticmonitor.UpdateStatus(c.dynamic, list[i])
+		if err != nil {
+			log.WithFields(log.Fields{
+				"namespace": list[i].ObjectMeta.Namespace,
+				"name":      list[i].ObjectMeta.Name,
+			}).Warnln("Failed to update status:", err)
+		}
+	}
+
+	return nil
+}
+
+// Helper function to delete monitors which were provisioned for SyntheticMonitors which no longer exist, or under
+// a name which the SyntheticMonitor no longer uses. Names maps each SyntheticMonitor to the name of its monitor.
+// Only monitors tagged as owned by a SyntheticMonitor in this namespace are considered, and nothing is
+// deleted if the run would delete more than the safety limits allow.
+func (cmd *command) collectGarbage(client *api.Client, names map[string]string, rpt *report.Report) error {
+	list, err := entityutils.SearchTag(client, entityutils.TagSyntheticMonitorNamespace, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	type removal struct {
+		result *report.Result
+		entity *entityutils.Entity
+	}
+
+	var (
+		owned    int
+		removals []removal
+	)
+
+	for _, entity := range list {
+		namespace, _ := entityutils.TagValue(entity.Tags, entityutils.TagSyntheticMonitorNamespace)
+
+		name, ok := entityutils.TagValue(entity.Tags, entityutils.TagSyntheticMonitorName)
+		if !ok || name == "" || namespace != cmd.Namespace {
+			continue
+		}
+
+		owned++
+
+		if want, ok := names[name]; ok && want == entity.Name {
+			continue
+		}
+
+		logger := log.WithFields(log.Fields{
+			"namespace": cmd.Namespace,
+			"name":      name,
+			"monitor":   entity.Name,
+		})
+
+		result := &report.Result{
+			Namespace: cmd.Namespace,
+			Route:     name,
+			Monitor:   entity.Name,
+			GUID:      entity.GUID,
+		}
+
+		rpt.Add(result)
+
+		if entityutils.Protected(entity.Tags) {
+			logger.Infoln("Skipping. Monitor is protected by the tag:", entityutils.TagDoNotDelete)
+			result.Action = report.ActionSkipped
+			result.Reason = fmt.Sprintf("monitor is protected by the %s tag", entityutils.TagDoNotDelete)
+			continue
+		}
+
+		id, err := entityutils.MonitorID(entity.GUID)
+		if err != nil {
+			result.Action = report.ActionFailed
+			result.Error = err
+			continue
+		}
+
+		result.MonitorID = id
+
+		removals = append(removals, removal{
+			result: result,
+			entity: entity,
+		})
+	}
+
+	// Nothing is deleted if the run looks like it would delete more than expected.
+	err = cmd.Safety.Check(len(removals), owned, len(names), "SyntheticMonitors")
+	if err != nil {
+		for _, r := range removals {
+			r.result.Reason = "safety limit exceeded"
+		}
+
+		return err
+	}
+
+	pool := worker.New(cmd.Concurrency)
+
+	for _, r := range removals {
+		r := r
+
+		pool.Go(func() error {
+			inventory.Cascade(client, rpt, r.result, r.entity.Tags, cmd.DryRun)
+			return nil
+		})
+	}
+
+	return pool.Wait()
+}
+
+// Helper function to build the desired monitor for a SyntheticMonitor.
+func (cmd *command) desired(client routeclient.RoutesGetter, sm *v1alpha1.SyntheticMonitor) (synthetics.Monitor, error) {
+	err := settings.ValidateMonitor(sm.Spec)
+	if err != nil {
+		return synthetics.Monitor{}, err
+	}
+
+	uri := sm.Spec.Target.URL
+
+	if target := sm.Spec.Target.Route; target != nil {
+		route, err := client.Routes(sm.ObjectMeta.Namespace).Get(context.Background(), target.Name, metav1.GetOptions{})
+		if err != nil {
+			return synthetics.Monitor{}, err
+		}
+
+		u := routeutils.URL(*route)
+
+		if target.Path != "" {
+			u.Path = target.Path
+		}
+
+		uri = u.String()
+	}
+
+	if uri == "" {
+		return synthetics.Monitor{}, fmt.Errorf("target must be a route or url")
+	}
+
+	monitor := synthetics.Monitor{
+		Name:         name(sm),
+		Type:         monitorutils.DefaultType,
+		Frequency:    monitorutils.DefaultFrequency,
+		URI:          uri,
+		Locations:    []string{cmd.NewRelicLocation},
+		Status:       monitorutils.DefaultStatus,
+		SLAThreshold: monitorutils.DefaultSLAThreshold,
+		Options: synthetics.MonitorOptions{
+			ValidationString:       sm.Spec.Options.ValidationString,
+			VerifySSL:              sm.Spec.Options.VerifySSL,
+			BypassHEADRequest:      sm.Spec.Options.BypassHEADRequest,
+			TreatRedirectAsFailure: sm.Spec.Options.TreatRedirectAsFailure,
+		},
+	}
+
+	if sm.Spec.Type != "" {
+		monitor.Type = synthetics.MonitorType(sm.Spec.Type)
+	}
+
+	if sm.Spec.Frequency != 0 {
+		monitor.Frequency = sm.Spec.Frequency
+	}
+
+	if len(sm.Spec.Locations) > 0 {
+		monitor.Locations = sm.Spec.Locations
+	}
+
+	if sm.Spec.SLAThreshold != 0 {
+		monitor.SLAThreshold = sm.Spec.SLAThreshold
+	}
+
+	if sm.Spec.Status != "" {
+		monitor.Status = synthetics.MonitorStatusType(sm.Spec.Status)
+	}
+
+	return monitor, nil
+}
+
+// Helper function to read the script for a SyntheticMonitor from a ConfigMap.
+func readScript(client coreclient.ConfigMapsGetter, sm *v1alpha1.SyntheticMonitor) (string, error) {
+	configMap, err := client.ConfigMaps(sm.ObjectMeta.Namespace).Get(context.Background(), sm.Spec.Script.ConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	script, ok := configMap.Data[sm.Spec.Script.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in configmap %s", sm.Spec.Script.Key, sm.Spec.Script.ConfigMap)
+	}
+
+	return script, nil
+}
+
+// Helper function to determine the monitor name for a SyntheticMonitor.
+func name(sm *v1alpha1.SyntheticMonitor) string {
+	if sm.Spec.Name != "" {
+		return fmt.Sprintf("%s/%s", sm.ObjectMeta.Namespace, sm.Spec.Name)
+	}
+
+	return fmt.Sprintf("%s/%s", sm.ObjectMeta.Namespace, sm.ObjectMeta.Name)
+}
+
+// Helper function to load the tags of the existing monitors which the SyntheticMonitors match by name, keyed by monitor ID.
+func owners(client *api.Client, monitors []*synthetics.Monitor, list []v1alpha1.SyntheticMonitor) (map[string][]*entities.Tag, error) {
+	var names []string
+
+	for i := range list {
+		if _, ok := monitorutils.Find(monitors, name(&list[i])); ok {
+			names = append(names, name(&list[i]))
+		}
+	}
+
+	found, err := entityutils.SearchNames(client, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup monitor tags: %w", err)
+	}
+
+	tags := make(map[string][]*entities.Tag, len(found))
+
+	for _, entity := range found {
+		if id, err := entityutils.MonitorID(entity.GUID); err == nil {
+			tags[id] = entity.Tags
+		}
+	}
+
+	return tags, nil
+}
+
+// Helper function to describe the owner of a monitor when it belongs to a Route or another SyntheticMonitor.
+// Monitors without owner tags eg. created by hand are not owned by anyone.
+func foreignOwner(tags []*entities.Tag, sm *v1alpha1.SyntheticMonitor) string {
+	if routes := entityutils.Owners(tags); len(routes) > 0 {
+		return fmt.Sprintf("Route %s", routes[0])
+	}
+
+	namespace, _ := entityutils.TagValue(tags, entityutils.TagSyntheticMonitorNamespace)
+	owner, _ := entityutils.TagValue(tags, entityutils.TagSyntheticMonitorName)
+
+	if owner == "" || (namespace == sm.ObjectMeta.Namespace && owner == sm.ObjectMeta.Name) {
+		return ""
+	}
+
+	return fmt.Sprintf("SyntheticMonitor %s/%s", namespace, owner)
+}
+
+// Helper function to set the Ready condition on a SyntheticMonitor.
+func setReady(sm *v1alpha1.SyntheticMonitor, status metav1.ConditionStatus, reason, message string) {
+	sm.Status.ObservedGeneration = sm.ObjectMeta.Generation
+
+	meta.SetStatusCondition(&sm.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.ConditionReady,
+		Status:             status,
+		ObservedGeneration: sm.ObjectMeta.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+func (cmd *command) run(c *kingpin.ParseContext) error {
+	metrics.Serve(cmd.MetricsAddr)
+
+	config, err := clientcmd.BuildConfigFromFlags(cmd.KubernetesMasterURL, cmd.KubernetesConfig)
+	if err != nil {
+		return err
+	}
+
+	return cmd.Election.Run(config, func() error {
+		return schedule.Run(cmd.Interval, cmd.once)
+	})
+}
+
+// Helper function to execute a single run.
+func (cmd *command) once() error {
+	config, err := clientcmd.BuildConfigFromFlags(cmd.KubernetesMasterURL, cmd.KubernetesConfig)
+	if err != nil {
+		return err
+	}
+
+	routeClient, err := routeclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	coreClient, err := coreclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	list, err := syntheticmonitor.List(dynamicClient, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	acc, err := account.Resolve(coreClient, cmd.Namespace, cmd.NewRelicAccount)
+	if err != nil {
+		return err
+	}
+
+	client, err := acc.Client(cmd.NewRelicLimits)
+	if err != nil {
+		return err
+	}
+
+	defer client.LogCalls()
+
+	rpt := report.New()
+
+	err = cmd.syncMonitors(clients{
+		newRelic: client,
+		routes:   routeClient,
+		core:     coreClient,
+		dynamic:  dynamicClient,
+	}, list, rpt)
+	if err != nil {
+		return err
+	}
+
+	err = rpt.Summary(os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Report.Write(rpt, "sync-monitors")
+	if err != nil {
+		return err
+	}
+
+	metrics.Report("sync-monitors", rpt)
+
+	err = rpt.Err()
+	if err != nil {
+		return err
+	}
+
+	metrics.Success("sync-monitors")
+
+	return nil
+}
+
+// Command which executes a command for an environment.
+func Command(app *kingpin.Application) {
+	c := new(command)
+
+	command := app.Command("sync-monitors", "Sync SyntheticMonitor resources to New Relic Synthetics monitors.").Action(c.run)
+
+	c.NewRelicAccount.Flags(command)
+	command.Flag("new-relic-location", "Location which monitors will be provisioned when a SyntheticMonitor does not declare any").Default("AWS_AP_SOUTHEAST_2").StringVar(&c.NewRelicLocation)
+	c.NewRelicLimits.Flags(command)
+
+	command.Flag("kubernetes-master-url", "URL of the Kubernetes master").Envar("KUBERNETES_MASTER_URL").StringVar(&c.KubernetesMasterURL)
+	command.Flag("kubernetes-config", "Path to the Kubernetes config file").Envar("KUBERNETES_CONFIG").StringVar(&c.KubernetesConfig)
+
+	command.Flag("dry-run", "Print out information which would have been executed").Envar("DRY_RUN").BoolVar(&c.DryRun)
+	command.Flag("concurrency", "Number of SyntheticMonitors which are synced at the same time").Envar("CONCURRENCY").Default("10").IntVar(&c.Concurrency)
+	c.Report.Flags(command)
+	command.Flag("metrics-addr", "Address to serve Prometheus metrics on eg. :9090").Envar("METRICS_ADDR").StringVar(&c.MetricsAddr)
+	command.Flag("interval", "Run continuously on this interval instead of once eg. 5m").Envar("INTERVAL").DurationVar(&c.Interval)
+	c.Election.Flags(command)
+	c.Safety.Flags(command)
+
+	command.Arg("namespace", "Namespace where SyntheticMonitors will be queried").Required().StringVar(&c.Namespace)
+}
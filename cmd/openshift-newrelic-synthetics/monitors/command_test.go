@@ -0,0 +1,330 @@
+package monitors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+	kubefake "github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/fake"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/fake"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/safety"
+)
+
+const namespace = "test"
+
+func newClient(t *testing.T, server *fake.Server) *api.Client {
+	client, err := api.New(api.Limits{Retries: 2, Backoff: time.Millisecond}, server.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// Dynamic client which accepts every status update.
+type statusClient struct {
+	dynamic.Interface
+}
+
+func (statusClient) Resource(schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return statusResource{}
+}
+
+type statusResource struct {
+	dynamic.NamespaceableResourceInterface
+}
+
+func (r statusResource) Namespace(string) dynamic.ResourceInterface {
+	return r
+}
+
+func (statusResource) UpdateStatus(_ context.Context, obj *unstructured.Unstructured, _ metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	return obj, nil
+}
+
+func newSyntheticMonitor(name, monitorName string) v1alpha1.SyntheticMonitor {
+	return v1alpha1.SyntheticMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: v1alpha1.SyntheticMonitorSpec{
+			Target: v1alpha1.Target{URL: "https://" + name + ".example.com/health"},
+			Name:   monitorName,
+		},
+	}
+}
+
+// Helper function to seed a monitor which was provisioned for a SyntheticMonitor.
+func addMonitor(server *fake.Server, name string, tags ...entities.Tag) string {
+	tags = append(tags,
+		entities.Tag{Key: entityutils.TagSyntheticMonitorNamespace, Values: []string{namespace}},
+		entities.Tag{Key: entityutils.TagSyntheticMonitorName, Values: []string{name}},
+	)
+
+	return server.AddMonitor(synthetics.Monitor{
+		Name:   namespace + "/" + name,
+		Type:   synthetics.MonitorTypes.Ping,
+		Status: synthetics.MonitorStatus.Enabled,
+	}, tags...)
+}
+
+// Helper function to check which monitors still exist.
+func exists(server *fake.Server, names ...string) map[string]bool {
+	found := make(map[string]bool, len(names))
+
+	for _, name := range names {
+		_, found[name] = server.Monitor(namespace + "/" + name)
+	}
+
+	return found
+}
+
+func TestCollectGarbage(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, "kept")
+	addMonitor(server, "gone")
+	addMonitor(server, "protected", entities.Tag{Key: entityutils.TagDoNotDelete, Values: []string{"true"}})
+
+	// Monitors which aren't tagged with the SyntheticMonitor which owns them are never collected.
+	server.AddMonitor(synthetics.Monitor{
+		Name: namespace + "/unowned",
+		Type: synthetics.MonitorTypes.Ping,
+	}, entities.Tag{Key: entityutils.TagSyntheticMonitorNamespace, Values: []string{namespace}})
+
+	cmd := &command{
+		Concurrency: 2,
+		Namespace:   namespace,
+	}
+
+	rpt := report.New()
+
+	err := cmd.collectGarbage(newClient(t, server), map[string]string{"kept": namespace + "/kept"}, rpt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]bool{
+		"kept":      true,
+		"gone":      false,
+		"protected": true,
+		"unowned":   true,
+	}
+
+	for name, found := range exists(server, "kept", "gone", "protected", "unowned") {
+		if found != want[name] {
+			t.Errorf("expected %s to exist: %t, got %t", name, want[name], found)
+		}
+	}
+}
+
+func TestCollectGarbageWithoutSyntheticMonitors(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, "one")
+	addMonitor(server, "two")
+
+	kube := kubefake.New()
+
+	cmd := &command{
+		Concurrency: 2,
+		Namespace:   namespace,
+	}
+
+	c := clients{
+		newRelic: newClient(t, server),
+		routes:   kube.Route,
+		core:     kube.Core,
+	}
+
+	err := cmd.syncMonitors(c, nil, report.New())
+	if err == nil {
+		t.Fatal("expected the run to be aborted because no SyntheticMonitors were found")
+	}
+
+	if requests := server.Requests(fake.OperationDeleteMonitor); requests != 0 {
+		t.Errorf("expected no monitors to be deleted, got %d requests", requests)
+	}
+
+	cmd.Safety = safety.Limits{Force: true}
+
+	err = cmd.syncMonitors(c, nil, report.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 0 {
+		t.Errorf("expected every monitor to be deleted with --force, got %d", len(monitors))
+	}
+}
+
+func TestCollectGarbageMaxDeletes(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits safety.Limits
+	}{
+		{name: "count", limits: safety.Limits{MaxDeletes: 1}},
+		{name: "percent", limits: safety.Limits{MaxDeletesPercent: 10}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := fake.New(1)
+			defer server.Close()
+
+			// Enough monitors for the percentage limit to apply.
+			names := make(map[string]string)
+
+			for i := 0; i < 8; i++ {
+				name := fmt.Sprintf("kept-%d", i)
+				addMonitor(server, name)
+				names[name] = namespace + "/" + name
+			}
+
+			addMonitor(server, "one")
+			addMonitor(server, "two")
+
+			cmd := &command{
+				Concurrency: 2,
+				Safety:      test.limits,
+				Namespace:   namespace,
+			}
+
+			rpt := report.New()
+
+			err := cmd.collectGarbage(newClient(t, server), names, rpt)
+			if err == nil {
+				t.Fatal("expected the safety limit to abort the run")
+			}
+
+			if monitors := server.Monitors(); len(monitors) != 10 {
+				t.Errorf("expected every monitor to be kept, got %d", len(monitors))
+			}
+		})
+	}
+}
+
+func TestSyncRefusesForeignMonitors(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	// Provisioned for a Route which happens to share the name.
+	route := server.AddMonitor(synthetics.Monitor{
+		Name: namespace + "/web",
+		Type: synthetics.MonitorTypes.Ping,
+	},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteNamespace, Values: []string{"other"}},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteName, Values: []string{"web"}},
+	)
+
+	// Provisioned for another SyntheticMonitor in this namespace, which has since been deleted.
+	addMonitor(server, "api")
+
+	// Created by hand, which is taken over.
+	unowned := server.AddMonitor(synthetics.Monitor{
+		Name: namespace + "/unowned",
+		Type: synthetics.MonitorTypes.Ping,
+	})
+
+	kube := kubefake.New()
+
+	cmd := &command{
+		Concurrency:      2,
+		NewRelicLocation: "AWS_AP_SOUTHEAST_2",
+		Namespace:        namespace,
+	}
+
+	c := clients{
+		newRelic: newClient(t, server),
+		routes:   kube.Route,
+		core:     kube.Core,
+		dynamic:  statusClient{},
+	}
+
+	list := []v1alpha1.SyntheticMonitor{
+		newSyntheticMonitor("web", ""),
+		newSyntheticMonitor("health", "api"),
+		newSyntheticMonitor("unowned", ""),
+		newSyntheticMonitor("duplicate", "unowned"),
+	}
+
+	rpt := report.New()
+
+	err := cmd.syncMonitors(c, list, rpt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := server.Tags(route)[entityutils.TagOpenShiftRouteName]; len(got) != 1 || got[0] != "web" {
+		t.Errorf("expected the route's monitor to keep its owner, got %v", server.Tags(route))
+	}
+
+	if monitor, _ := server.Monitor(namespace + "/web"); monitor.URI != "" {
+		t.Errorf("expected the route's monitor to be left alone, got %q", monitor.URI)
+	}
+
+	if got := server.Tags(unowned)[entityutils.TagSyntheticMonitorName]; len(got) != 1 || got[0] != "unowned" {
+		t.Errorf("expected the unowned monitor to be taken over, got %v", server.Tags(unowned))
+	}
+
+	failed := make(map[string]bool)
+
+	for _, result := range rpt.Results() {
+		if result.Action == report.ActionFailed {
+			failed[result.Route] = true
+		}
+	}
+
+	if !failed["web"] || !failed["health"] || !failed["duplicate"] || failed["unowned"] {
+		t.Errorf("expected web, health and duplicate to be refused, got %v", failed)
+	}
+}
+
+func TestCollectGarbageRenamed(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	addMonitor(server, "kept")
+
+	// Provisioned under the name which the SyntheticMonitor used before.
+	server.AddMonitor(synthetics.Monitor{
+		Name: "old name",
+		Type: synthetics.MonitorTypes.Ping,
+	},
+		entities.Tag{Key: entityutils.TagSyntheticMonitorNamespace, Values: []string{namespace}},
+		entities.Tag{Key: entityutils.TagSyntheticMonitorName, Values: []string{"kept"}},
+	)
+
+	cmd := &command{
+		Concurrency: 2,
+		Namespace:   namespace,
+	}
+
+	err := cmd.collectGarbage(newClient(t, server), map[string]string{"kept": namespace + "/kept"}, report.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := server.Monitor("old name"); ok {
+		t.Error("expected the monitor under the old name to be collected")
+	}
+
+	if _, ok := server.Monitor(namespace + "/kept"); !ok {
+		t.Error("expected the current monitor to be kept")
+	}
+}
@@ -1,142 +1,889 @@
 package sync
 
 import (
-	"net/url"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
-	"github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
 	"github.com/newrelic/newrelic-client-go/pkg/entities"
 	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
 	routev1 "github.com/openshift/api/route/v1"
+	routeclient "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/alecthomas/kingpin.v2"
+	"k8s.io/client-go/dynamic"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/adopt"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/dedupe"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/drift"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/leader"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/metrics"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/account"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/condition"
 	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/inventory"
 	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor/cache"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/pending"
 	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/schedule"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/settings"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/worker"
 )
 
 type command struct {
-	NewRelicAPIKey      string
-	NewRelicLocation    string
-	KubernetesMasterURL string
-	KubernetesConfig    string
-	DryRun              bool
-	Namespace           string
+	NewRelicAccount       account.Account
+	NewRelicLocation      string
+	NewRelicLimits        api.Limits
+	KubernetesMasterURL   string
+	KubernetesConfig      string
+	KubernetesEvents      bool
+	KubernetesAnnotations bool
+	DryRun                bool
+	Concurrency           int
+	Report                report.Output
+	MetricsAddr           string
+	Interval              time.Duration
+	Election              leader.Election
+	Claims                entityutils.Claims
+	RoutesFile            string
+	Adopt                 bool
+	Drift                 drift.Options
+	MonitorCache          cache.Options
+	Polling               entityutils.Polling
+	PendingTags           pending.Options
+	Namespace             string
+
+	monitorCache *cache.Cache
+	queue        *pending.Queue
 }
 
-func syncSynthetics(client *newrelic.NewRelic, routes []routev1.Route, location string, dryRun bool) error {
-	monitors, err := monitorutils.List(client)
+func (cmd *command) syncSynthetics(client *api.Client, routes []routev1.Route, policies []v1alpha1.SyntheticsPolicy, namespaceLabels map[string]string, rpt *report.Report) error {
+	monitors, err := cmd.monitorCache.List(client, time.Now())
+	if err != nil {
+		return err
+	}
+
+	cmd.duplicates(monitors, routes)
+
+	adopted, unadopted, err := cmd.adopt(client, monitors, routes)
 	if err != nil {
 		return err
 	}
 
+	// Cached monitors are read again before they are compared for drift or updated, so edits made in the UI since
+	// they were cached aren't missed or reverted.
+	names := make(map[string]bool, len(routes))
+
+	for urlString := range routeutils.Group(routes) {
+		names[urlString] = true
+	}
+
+	monitors, err = cmd.monitorCache.Verify(client, monitors, names)
+	if err != nil {
+		return err
+	}
+
+	existing, err := cmd.existing(client, monitors, routes)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	var (
+		mu     sync.Mutex
+		edited int64
+	)
+
 	tags := make(map[string][]entities.Tag, len(routes))
+	ids := make(map[string]string, len(routes))
+	results := make(map[string]*report.Result, len(routes))
+	inventories := make(map[string][]inventory.Item, len(routes))
+	owners := make(map[string][]routev1.Route, len(routes))
+	primaries := make(map[string]*report.Result, len(routes))
 
-	for _, route := range routes {
-		uri := url.URL{
-			Scheme: "http", // @todo, Find a cost.
-			Host:   route.Spec.Host,
-			Path:   route.Spec.Path,
+	groups, shared := cmd.consolidate(routes, policies, namespaceLabels, rpt)
+
+	// Routes which share a monitor are annotated with it once the sync has finished with it.
+	defer share(primaries, shared)
+
+	pool := worker.New(cmd.Concurrency)
+
+	for urlString, group := range groups {
+		urlString, group := urlString, group
+
+		// The first Route in each group provisions the monitor for every Route which shares its URL.
+		route := group[0]
+
+		result := &report.Result{
+			Namespace: route.ObjectMeta.Namespace,
+			Route:     route.ObjectMeta.Name,
+			URL:       urlString,
+			Monitor:   urlString,
+		}
+
+		rpt.Add(result)
+
+		primaries[urlString] = result
+
+		pool.Go(func() error {
+			logger := log.WithFields(log.Fields{
+				"namespace": route.ObjectMeta.Namespace,
+				"name":      route.ObjectMeta.Name,
+				"url":       urlString,
+			})
+
+			if reason, ok := adopted[urlString]; ok {
+				result.Reason = reason
+			}
+
+			// Creating a monitor would duplicate the monitor which failed to be adopted.
+			if err, ok := unadopted[urlString]; ok {
+				result.Action = report.ActionFailed
+				result.Error = fmt.Errorf("failed to adopt monitor: %w", err)
+				return nil
+			}
+
+			// Typically whitelisting is used for limiting traffic which can view the site.
+			// @todo, Consider alternatives to skipping routes with a whitelist.
+			if whitelisted(route) {
+				logger.Infoln("Skipping this route because the following annotation is set:", routeutils.AnnotationIPWhitelist)
+				result.Action = report.ActionSkipped
+				result.Reason = fmt.Sprintf("annotation is set: %s", routeutils.AnnotationIPWhitelist)
+				return nil
+			}
+
+			ok, reason := cmd.Claims.Check(existing[urlString], now)
+			if !ok {
+				logger.Infoln("Skipping this route because the monitor is", reason)
+				result.Action = report.ActionSkipped
+				result.Reason = reason
+				return nil
+			}
+
+			if reason != "" {
+				logger.Warnln("Claiming monitor:", reason)
+			}
+
+			effective, err := settings.Resolve(settings.Defaults(cmd.NewRelicLocation), policies, namespaceLabels, route)
+			if err != nil {
+				logger.Errorln("Failed to resolve monitor settings:", err)
+				result.Action = report.ActionFailed
+				result.Error = fmt.Errorf("failed to resolve monitor settings: %w", err)
+				return nil
+			}
+
+			if !effective.Enabled {
+				source := effective.Sources[settings.FieldEnabled]
+				logger.Infoln("Skipping this route because monitoring is disabled by:", source)
+				result.Action = report.ActionSkipped
+				result.Reason = fmt.Sprintf("monitoring is disabled by %s", source)
+				return nil
+			}
+
+			monitor := effective.Monitor(urlString)
+			owned := effective.ManagedFields
+
+			// Cleanup disables a monitor when it tombstones it, so the status has to be restored along with the Route
+			// even when it isn't managed. Otherwise the monitor would stay disabled once the tombstone is removed.
+			if tombstoned(existing[urlString]) {
+				owned = own(owned, monitorutils.FieldStatus)
+			}
+
+			var (
+				accepted []report.Difference
+				// Monitor which has drifted and is only being reported, so its fields are left alone.
+				reported *synthetics.Monitor
+			)
+
+			// Tombstoned monitors are disabled by cleanup, so they are restored rather than treated as drift.
+			if live, ok := monitorutils.Find(monitors, urlString); ok && !tombstoned(existing[urlString]) {
+				var differences []report.Difference
+
+				// Fields which aren't managed are left to be tuned in the New Relic UI, so they can't drift.
+				monitor = monitorutils.Merge(*live, monitor, owned)
+
+				differences, accepted = drift.Resolve(&monitor, *live, drift.Accepted(existing[urlString]), drift.Policy(cmd.Drift.Policy))
+
+				if len(differences) > 0 {
+					atomic.AddInt64(&edited, 1)
+
+					logger.WithField("drift", drift.Summary(differences)).Warnln("Monitor has drifted from its desired state")
+
+					result.MonitorID = live.ID
+					result.Drift = differences
+
+					switch drift.Policy(cmd.Drift.Policy) {
+					case drift.PolicyReport:
+						result.Action = report.ActionDrifted
+						result.Reason = fmt.Sprintf("monitor has drifted: %s", drift.Summary(differences))
+						reported = live
+					case drift.PolicyAccept:
+						result.Reason = fmt.Sprintf("accepted drift: %s", drift.Summary(differences))
+					default:
+						result.Reason = fmt.Sprintf("reverted drift: %s", drift.Summary(differences))
+					}
+				}
+			}
+
+			if cmd.DryRun {
+				if reported == nil {
+					logger.Infoln("Dry run is enabled. A monitor would have been created or updated for this route.")
+					result.Action = report.ActionDryRun
+				}
+
+				return nil
+			}
+
+			// A monitor which has drifted is still tagged, so its owners and claim heartbeat are kept up to date.
+			m := reported
+
+			if m == nil {
+				var created bool
+
+				logger.Infoln("Creating/Updating monitor")
+
+				m, created, err = monitorutils.CreateOrUpdate(client, monitors, monitor, owned)
+				if err != nil {
+					logger.Errorln("Failed to create/update monitor:", err)
+					result.Action = report.ActionFailed
+					result.Error = fmt.Errorf("failed to create/update monitor: %w", err)
+					return nil
+				}
+
+				cmd.monitorCache.Put(m)
+
+				result.MonitorID = m.ID
+				result.Action = report.ActionUpdated
+
+				if created {
+					result.Action = report.ActionCreated
+				}
+			}
+
+			var items []inventory.Item
+
+			if effective.AlertPolicyID != 0 {
+				cond, err := condition.Ensure(client, effective.AlertPolicyID, alerts.SyntheticsCondition{
+					Name:      m.Name,
+					Enabled:   true,
+					MonitorID: m.ID,
+				})
+				if err != nil {
+					logger.Errorln("Failed to ensure alert condition:", err)
+					result.Action = report.ActionFailed
+					result.Error = fmt.Errorf("failed to ensure alert condition: %w", err)
+					return nil
+				}
+
+				items = append(items, inventory.AlertCondition(effective.AlertPolicyID, cond.ID))
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			ids[m.Name] = m.ID
+			results[m.Name] = result
+			inventories[m.Name] = items
+			owners[m.Name] = group
+
+			tags[m.Name] = append(cmd.Claims.Tags(existing[m.Name], now), drift.Tags(accepted)...)
+
+			return nil
+		})
+	}
+
+	err = pool.Wait()
+	if err != nil {
+		return err
+	}
+
+	// The run can carry on without the cache, the next run will list every monitor instead.
+	err = cmd.monitorCache.Save()
+	if err != nil {
+		log.Warnln("Failed to save the monitor cache:", err)
+	}
+
+	metrics.Drift.WithLabelValues("fields").Set(float64(edited))
+
+	// Tags which were pending from previous runs are applied along with the tags for this run.
+	drain := cmd.pendingTags(monitors, ids)
+
+	defer cmd.savePendingTags()
+
+	if len(ids) == 0 && len(drain) == 0 {
+		return nil
+	}
+
+	lookup := make(map[string]string, len(ids)+len(drain))
+
+	for name, id := range ids {
+		lookup[name] = id
+	}
+
+	for name, entry := range drain {
+		lookup[name] = entry.MonitorID
+	}
+
+	list, err := cmd.Polling.Lookup(client, entityutils.TagOpenShiftRouteNamespace, cmd.Namespace, lookup)
+	if err != nil {
+		log.Errorln("Failed to lookup monitor entities:", err)
+
+		for _, result := range results {
+			result.Action = report.ActionFailed
+			result.Error = fmt.Errorf("failed to lookup monitor entity: %w", err)
 		}
 
-		if route.Spec.TLS != nil {
-			uri.Scheme = "https" // @todo, Find a cost.
+		return nil
+	}
+
+	var drifted int64
+
+	pool = worker.New(cmd.Concurrency)
+
+	for _, entity := range list {
+		entity := entity
+
+		if entry, ok := drain[entity.Name]; ok {
+			pool.Go(func() error {
+				cmd.applyPendingTags(client, rpt, entry, entity)
+				return nil
+			})
+
+			delete(drain, entity.Name)
+
+			continue
 		}
 
-		urlString := uri.String()
+		id := ids[entity.Name]
+		result := results[entity.Name]
 
-		logger := log.WithFields(log.Fields{
-			"namespace": route.ObjectMeta.Namespace,
-			"name":      route.ObjectMeta.Name,
-			"url":       urlString,
+		pool.Go(func() error {
+			logger := log.WithFields(log.Fields{
+				"name": entity.Name,
+				"guid": entity.GUID,
+			})
+
+			result.GUID = entity.GUID
+			result.Permalink = entity.Permalink
+
+			items := inventories[entity.Name]
+
+			// Routes in other namespaces record their own objects, which must not be pruned by this namespace.
+			if foreign := entityutils.ForeignOwners(entity.Tags, cmd.Namespace); len(foreign) > 0 {
+				logger.WithField("owners", foreign).Warnln("Monitor is shared with Routes in other namespaces")
+				items = inventory.Merge(inventory.FromTags(entity.Tags), items)
+			} else {
+				items = inventory.Prune(client, entity.Tags, items)
+			}
+
+			desired := entityutils.OwnerTags(entity.Tags, cmd.Namespace, owners[entity.Name])
+			desired = append(desired, tags[entity.Name]...)
+			desired = append(desired, inventory.Tags(items)...)
+			result.Tags = desired
+
+			logger.Infoln("Reconciling tags")
+
+			changes, err := entityutils.ReconcileTags(client, entity.GUID, entity.Tags, desired)
+			if err != nil {
+				logger.Errorln("Failed to reconcile tags:", err)
+				result.Action = report.ActionFailed
+				result.Error = fmt.Errorf("failed to reconcile tags: %w", err)
+				return nil
+			}
+
+			cmd.queue.Remove(id)
+
+			if changes.Empty() {
+				logger.Infoln("Tags are already up to date")
+				return nil
+			}
+
+			atomic.AddInt64(&drifted, 1)
+
+			return nil
+		})
+
+		delete(ids, entity.Name)
+	}
+
+	// Monitors without tags are invisible to cleanup, so their tags are queued for a later run to apply
+	// even if the Route has been deleted by then.
+	for name, id := range ids {
+		log.WithField("name", name).Warnln("Unable to apply tags because the monitor entity has not been indexed yet")
+
+		desired := entityutils.OwnerTags(nil, cmd.Namespace, owners[name])
+		desired = append(desired, tags[name]...)
+		desired = append(desired, inventory.Tags(inventories[name])...)
+
+		reason := "tags are pending because the monitor entity has not been indexed yet"
+
+		result := results[name]
+		result.Tags = desired
+
+		if result.Reason != "" {
+			reason = result.Reason + "; " + reason
+		}
+
+		result.Reason = reason
+
+		cmd.queue.Add(pending.Entry{
+			Namespace: cmd.Namespace,
+			Route:     owners[name][0].ObjectMeta.Name,
+			Name:      name,
+			MonitorID: id,
+			Tags:      desired,
+			QueuedAt:  now,
 		})
+	}
 
-		// Typically whitelisting is used for limiting traffic which can view the site.
-		// @todo, Consider alternatives to skipping routes with a whitelist.
-		if _, ok := route.ObjectMeta.Annotations[routeutils.AnnotationIPWhitelist]; ok {
-			logger.Infoln("Skipping this route because the following annotation is set:", routeutils.AnnotationIPWhitelist)
+	for _, entry := range drain {
+		cmd.untagged(rpt, entry)
+	}
+
+	err = pool.Wait()
+	if err != nil {
+		return err
+	}
+
+	metrics.Drift.WithLabelValues("tags").Set(float64(drifted))
+
+	return nil
+}
+
+// Helper function to check if a monitor was disabled by cleanup ahead of being deleted.
+func tombstoned(tags []*entities.Tag) bool {
+	_, ok := entityutils.GetTombstone(tags)
+	return ok
+}
+
+// Helper function to add a field to a list of managed fields. Every field is already managed when none are listed.
+func own(owned []string, field string) []string {
+	if len(owned) == 0 {
+		return owned
+	}
+
+	for _, existing := range owned {
+		if existing == field {
+			return owned
+		}
+	}
+
+	return append(append([]string{}, owned...), field)
+}
+
+// Helper function to group the Routes which share a URL, so they are merged into a single monitor instead of fighting over it.
+// Every Route other than the first in a group is reported as sharing the monitor of the first Route, these results are
+// returned keyed by URL so they can be given the monitor once it is synced. Routes which would configure the monitor
+// differently are all failed instead, otherwise the monitor would be provisioned from whichever Route sorts first.
+func (cmd *command) consolidate(routes []routev1.Route, policies []v1alpha1.SyntheticsPolicy, namespaceLabels map[string]string, rpt *report.Report) (map[string][]routev1.Route, map[string][]*report.Result) {
+	groups := routeutils.Group(routes)
+	shared := make(map[string][]*report.Result)
+
+	for urlString, group := range groups {
+		if len(group) < 2 {
 			continue
 		}
 
-		monitor := synthetics.Monitor{
-			Name:      urlString,
-			Type:      "BROWSER", // @todo, Make configurable.
-			Frequency: 1, // @todo, Make configurable.
-			URI:       urlString,
-			Locations: []string{
-				location,
-			},
-			Status:       "ENABLED", // @todo, Make configurable.
-			SLAThreshold: 7, // @todo, Make configurable.
+		var conflicting []string
+
+		for _, route := range group {
+			conflicting = append(conflicting, entityutils.RouteOwner(route).String())
 		}
 
-		if dryRun {
-			logger.Infoln("Dry run is enabled. A monitor would have been created or updated for this route.")
+		logger := log.WithFields(log.Fields{
+			"url":    urlString,
+			"routes": strings.Join(conflicting, ", "),
+		})
+
+		if differences := cmd.differences(group, policies, namespaceLabels); len(differences) > 0 {
+			logger.WithField("differences", strings.Join(differences, "; ")).Errorln("Refusing to merge Routes which share a URL because they are configured differently")
+
+			for _, route := range group {
+				rpt.Add(&report.Result{
+					Namespace: route.ObjectMeta.Namespace,
+					Route:     route.ObjectMeta.Name,
+					URL:       urlString,
+					Monitor:   urlString,
+					Action:    report.ActionFailed,
+					Error:     fmt.Errorf("routes which share the URL are configured differently: %s", strings.Join(differences, "; ")),
+				})
+			}
+
+			delete(groups, urlString)
+
 			continue
 		}
 
-		logger.Infoln("Creating/Updating monitor")
+		logger.Warnln("Routes share a URL and will be merged into a single monitor")
+
+		for _, route := range group[1:] {
+			result := &report.Result{
+				Namespace: route.ObjectMeta.Namespace,
+				Route:     route.ObjectMeta.Name,
+				URL:       urlString,
+				Monitor:   urlString,
+				Action:    report.ActionSkipped,
+				Reason:    fmt.Sprintf("monitor is shared with %s", conflicting[0]),
+			}
+
+			rpt.Add(result)
+
+			shared[urlString] = append(shared[urlString], result)
+		}
+	}
+
+	return groups, shared
+}
+
+// Helper function to describe how each Route in a group would configure the monitor differently to the first Route.
+func (cmd *command) differences(group []routev1.Route, policies []v1alpha1.SyntheticsPolicy, namespaceLabels map[string]string) []string {
+	first := group[0]
 
-		m, err := monitorutils.CreateOrUpdate(client, monitors, monitor)
+	// The first Route reports its own error when its monitor is synced.
+	want, err := settings.Resolve(settings.Defaults(cmd.NewRelicLocation), policies, namespaceLabels, first)
+	if err != nil {
+		return nil
+	}
+
+	var (
+		differences []string
+		owner       = entityutils.RouteOwner(first).String()
+	)
+
+	for _, route := range group[1:] {
+		other := entityutils.RouteOwner(route).String()
+
+		if whitelisted(route) != whitelisted(first) {
+			differences = append(differences, fmt.Sprintf("%s: %s=%t, %s=%t", routeutils.AnnotationIPWhitelist, owner, whitelisted(first), other, whitelisted(route)))
+		}
+
+		got, err := settings.Resolve(settings.Defaults(cmd.NewRelicLocation), policies, namespaceLabels, route)
 		if err != nil {
-			return err
+			differences = append(differences, fmt.Sprintf("%s: %s", other, err))
+			continue
 		}
 
-		tags[m.Name] = []entities.Tag{
-			{
-				Key: entityutils.TagOpenShiftRouteNamespace,
-				Values: []string{route.ObjectMeta.Namespace},
-			},
-			{
-				Key: entityutils.TagOpenShiftRouteName,
-				Values: []string{route.ObjectMeta.Name},
-			},
-			{
-				Key: entityutils.TagOpenShiftRouteToKind,
-				Values: []string{route.Spec.To.Kind},
-			},
-			{
-				Key: entityutils.TagOpenShiftRouteToName,
-				Values: []string{route.Spec.To.Name},
-			},
-		}
-	}
-
-	entities, err := client.Entities.SearchEntities(entities.SearchEntitiesParams{
-		Type: entityutils.TypeMonitor,
-	})
+		for _, field := range settings.Fields {
+			if got.Value(field) != want.Value(field) {
+				differences = append(differences, fmt.Sprintf("%s: %s=%s, %s=%s", field, owner, want.Value(field), other, got.Value(field)))
+			}
+		}
+	}
+
+	return differences
+}
+
+// Helper function to check if a Route is skipped because it has an IP whitelist.
+func whitelisted(route routev1.Route) bool {
+	_, ok := route.ObjectMeta.Annotations[routeutils.AnnotationIPWhitelist]
+	return ok
+}
+
+// Helper function to give the Routes which share a monitor the monitor which was synced for the first Route,
+// so every Route in the group is annotated with it.
+func share(primaries map[string]*report.Result, shared map[string][]*report.Result) {
+	for urlString, results := range shared {
+		primary, ok := primaries[urlString]
+		if !ok || primary.Error != nil || primary.MonitorID == "" {
+			continue
+		}
+
+		for _, result := range results {
+			result.MonitorID = primary.MonitorID
+			result.GUID = primary.GUID
+			result.Permalink = primary.Permalink
+		}
+	}
+}
+
+// Helper function to adopt monitors which were created by hand, renaming them so they are updated instead of duplicated.
+// Returns a description of each adoption and the error for each failed adoption, keyed by the URL of the Route.
+func (cmd *command) adopt(client *api.Client, monitors []*synthetics.Monitor, routes []routev1.Route) (map[string]string, map[string]error, error) {
+	adopted := make(map[string]string)
+	unadopted := make(map[string]error)
+
+	if !cmd.Adopt {
+		return adopted, unadopted, nil
+	}
+
+	plan, err := adopt.Plan(client, monitors, routes)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	for _, entity := range entities {
-		log.Infoln("Applying tags to:", entity.Name)
+	for _, adoption := range plan {
+		logger := log.WithFields(log.Fields{
+			"namespace": adoption.Route.ObjectMeta.Namespace,
+			"name":      adoption.Route.ObjectMeta.Name,
+			"url":       adoption.URL,
+		})
 
-		if _, ok := tags[entity.Name]; !ok {
+		monitor, ok := adoption.Monitor()
+		if !ok {
+			logger.Warnln("Unable to adopt a monitor because", adoption.Reason())
 			continue
 		}
 
-		err = client.Entities.AddTags(entity.GUID, tags[entity.Name])
+		if cmd.DryRun {
+			logger.Infoln("Dry run is enabled. The following monitor would have been adopted:", monitor.Name)
+			adopted[adoption.URL] = fmt.Sprintf("monitor %q would have been adopted", monitor.Name)
+			continue
+		}
+
+		previous := monitor.Name
+
+		logger.Infoln("Adopting monitor:", previous)
+
+		err := adopt.Rename(client, monitor, adoption.URL)
 		if err != nil {
-			return err
+			logger.Errorln("Failed to adopt monitor:", err)
+			unadopted[adoption.URL] = err
+			continue
 		}
+
+		cmd.monitorCache.Put(monitor)
+
+		adopted[adoption.URL] = fmt.Sprintf("adopted monitor %q", previous)
 	}
 
-	return nil
+	return adopted, unadopted, nil
+}
+
+// Helper function to warn about Routes which are backed by more than one monitor.
+// Duplicates are only reported here, the dedupe command is responsible for repairing them.
+func (cmd *command) duplicates(monitors []*synthetics.Monitor, routes []routev1.Route) {
+	names := dedupe.Names(monitors)
+
+	var found int
+
+	for _, route := range routes {
+		uri := routeutils.URL(route)
+
+		count, ok := names[uri.String()]
+		if !ok {
+			continue
+		}
+
+		found++
+
+		log.WithFields(log.Fields{
+			"namespace": route.ObjectMeta.Namespace,
+			"name":      route.ObjectMeta.Name,
+			"url":       uri.String(),
+			"monitors":  count,
+		}).Warnln("Route is backed by duplicate monitors, run the dedupe command to repair them")
+	}
+
+	metrics.Duplicates.WithLabelValues(cmd.Namespace).Set(float64(found))
+}
+
+// Helper function to lookup the tags of existing monitors so that claims from other clusters and accepted drift are respected.
+func (cmd *command) existing(client *api.Client, monitors []*synthetics.Monitor, routes []routev1.Route) (map[string][]*entities.Tag, error) {
+	existing := make(map[string][]*entities.Tag)
+
+	ids := make(map[string]string)
+
+	for _, route := range routes {
+		uri := routeutils.URL(route)
+
+		if id, ok := monitorutils.Exists(monitors, uri.String()); ok {
+			ids[uri.String()] = id
+		}
+	}
+
+	if len(ids) == 0 {
+		return existing, nil
+	}
+
+	list, err := entityutils.Lookup(client, entityutils.TagOpenShiftRouteNamespace, cmd.Namespace, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup monitor tags: %w", err)
+	}
+
+	for _, entity := range list {
+		existing[entity.Name] = entity.Tags
+	}
+
+	return existing, nil
 }
 
 func (cmd *command) run(c *kingpin.ParseContext) error {
-	routes, err := routeutils.List(cmd.KubernetesMasterURL, cmd.KubernetesConfig, cmd.Namespace)
+	// Stdin is consumed by the first run, so every run after it would see no Routes.
+	if cmd.RoutesFile == routeutils.Stdin && cmd.Interval > 0 {
+		return fmt.Errorf("--routes-file=%s cannot be used with --interval because stdin can only be read once", routeutils.Stdin)
+	}
+
+	metrics.Serve(cmd.MetricsAddr)
+
+	// Routes which are loaded from files don't require a cluster.
+	if cmd.RoutesFile != "" {
+		// The queue is created once so runs on an interval share it, even when it is only kept in memory.
+		queue, err := pending.New(cmd.PendingTags, nil, cmd.Namespace)
+		if err != nil {
+			return err
+		}
+
+		cmd.queue = queue
+
+		return schedule.Run(cmd.Interval, cmd.once)
+	}
+
+	config, err := clientcmd.BuildConfigFromFlags(cmd.KubernetesMasterURL, cmd.KubernetesConfig)
+	if err != nil {
+		return err
+	}
+
+	coreClient, err := coreclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	cmd.queue, err = pending.New(cmd.PendingTags, coreClient, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	return cmd.Election.Run(config, func() error {
+		return schedule.Run(cmd.Interval, cmd.once)
+	})
+}
+
+// Helper function to execute a single run.
+func (cmd *command) once() error {
+	if cmd.RoutesFile != "" {
+		return cmd.offline()
+	}
+
+	config, err := clientcmd.BuildConfigFromFlags(cmd.KubernetesMasterURL, cmd.KubernetesConfig)
+	if err != nil {
+		return err
+	}
+
+	routeClient, err := routeclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	coreClient, err := coreclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	policies, namespaceLabels, err := settings.Load(dynamicClient, coreClient, cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	acc, err := account.Resolve(coreClient, cmd.Namespace, cmd.NewRelicAccount)
+	if err != nil {
+		return err
+	}
+
+	client, err := acc.Client(cmd.NewRelicLimits)
+	if err != nil {
+		return err
+	}
+
+	defer client.LogCalls()
+
+	cmd.monitorCache, err = cache.New(cmd.MonitorCache, coreClient, acc.ID)
+	if err != nil {
+		return err
+	}
+
+	return cmd.execute(client, routeClient, coreClient, policies, namespaceLabels)
+}
+
+// Helper function to execute a single run against Routes loaded from files, without a cluster.
+// Policies and Namespace labels are not applied because they are read from the cluster.
+// The API key can't be read from a Secret in this mode.
+func (cmd *command) offline() error {
+	acc, err := cmd.NewRelicAccount.LoadAPIKey(nil)
 	if err != nil {
 		return err
 	}
 
-	client, err := newrelic.New(newrelic.ConfigPersonalAPIKey(cmd.NewRelicAPIKey))
+	client, err := acc.Client(cmd.NewRelicLimits)
 	if err != nil {
 		return err
 	}
 
-	return syncSynthetics(client, routes, cmd.NewRelicLocation, cmd.DryRun)
+	defer client.LogCalls()
+
+	cmd.monitorCache, err = cache.New(cmd.MonitorCache, nil, acc.ID)
+	if err != nil {
+		return err
+	}
+
+	return cmd.execute(client, nil, nil, nil, nil)
+}
+
+// Helper function to list the Routes from files when provided, otherwise from the cluster.
+func (cmd *command) routes(client routeclient.RoutesGetter) ([]routev1.Route, error) {
+	if cmd.RoutesFile != "" {
+		return routeutils.Load(cmd.RoutesFile, cmd.Namespace)
+	}
+
+	return routeutils.List(client, cmd.Namespace)
+}
+
+// Helper function to sync the Routes in the namespace and report on the results.
+func (cmd *command) execute(client *api.Client, routeClient routeclient.RoutesGetter, coreClient coreclient.EventsGetter, policies []v1alpha1.SyntheticsPolicy, namespaceLabels map[string]string) error {
+	routes, err := cmd.routes(routeClient)
+	if err != nil {
+		return err
+	}
+
+	metrics.RoutesDiscovered.WithLabelValues(cmd.Namespace).Set(float64(len(routes)))
+
+	rpt := report.New()
+
+	err = cmd.syncSynthetics(client, routes, policies, namespaceLabels, rpt)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.publish(routeClient, coreClient, routes, rpt)
+	if err != nil {
+		return err
+	}
+
+	err = rpt.Summary(os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Report.Write(rpt, "sync")
+	if err != nil {
+		return err
+	}
+
+	metrics.Report("sync", rpt)
+
+	err = rpt.Err()
+	if err != nil {
+		return err
+	}
+
+	metrics.Success("sync")
+
+	return nil
 }
 
 // Command which executes a command for an environment.
@@ -145,13 +892,28 @@ func Command(app *kingpin.Application) {
 
 	command := app.Command("sync", "Sync OpenShift Routes to New Relic Synthetics monitors.").Action(c.run)
 
-	command.Flag("new-relic-api-key", "API key for authenticating with New Relic").Envar("NEW_RELIC_API_KEY").Required().StringVar(&c.NewRelicAPIKey)
+	c.NewRelicAccount.Flags(command)
 	command.Flag("new-relic-location", "Location which monitors will be provisioned").Default("AWS_AP_SOUTHEAST_2").StringVar(&c.NewRelicLocation)
+	c.NewRelicLimits.Flags(command)
 
 	command.Flag("kubernetes-master-url", "URL of the Kubernetes master").Envar("KUBERNETES_MASTER_URL").StringVar(&c.KubernetesMasterURL)
 	command.Flag("kubernetes-config", "Path to the Kubernetes config file").Envar("KUBERNETES_CONFIG").StringVar(&c.KubernetesConfig)
+	command.Flag("kubernetes-events", "Record Kubernetes Events on each Route describing what happened to its monitor").Envar("KUBERNETES_EVENTS").Default("true").BoolVar(&c.KubernetesEvents)
+	command.Flag("kubernetes-annotations", "Write monitor status annotations back to each Route").Envar("KUBERNETES_ANNOTATIONS").Default("true").BoolVar(&c.KubernetesAnnotations)
 
 	command.Flag("dry-run", "Print out information which would have been executed").Envar("DRY_RUN").BoolVar(&c.DryRun)
+	command.Flag("concurrency", "Number of Routes which are synced at the same time").Envar("CONCURRENCY").Default("10").IntVar(&c.Concurrency)
+	c.Report.Flags(command)
+	command.Flag("metrics-addr", "Address to serve Prometheus metrics on eg. :9090").Envar("METRICS_ADDR").StringVar(&c.MetricsAddr)
+	command.Flag("interval", "Run continuously on this interval instead of once eg. 5m").Envar("INTERVAL").DurationVar(&c.Interval)
+	c.Election.Flags(command)
+	c.Claims.Flags(command)
+	c.Drift.Flags(command, drift.PolicyRevert)
+	c.MonitorCache.Flags(command)
+	c.Polling.Flags(command)
+	c.PendingTags.Flags(command)
+	command.Flag("adopt", "Adopt monitors which were created by hand for a Route instead of creating duplicates").Envar("ADOPT").BoolVar(&c.Adopt)
+	command.Flag("routes-file", "Load Routes from a manifest, directory of manifests or - for stdin instead of the cluster eg. oc get routes -o yaml").Envar("ROUTES_FILE").StringVar(&c.RoutesFile)
 
 	command.Arg("namespace", "Namespace where Routes will be queried").Required().StringVar(&c.Namespace)
 }
@@ -0,0 +1,908 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/drift"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/event"
+	kubefake "github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/fake"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/fake"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor/cache"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/pending"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+)
+
+const namespace = "test"
+
+func newRoute(name, host string, annotations map[string]string) *routev1.Route {
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Annotations: annotations,
+		},
+		Spec: routev1.RouteSpec{
+			Host: host,
+			To: routev1.RouteTargetReference{
+				Kind: "Service",
+				Name: name,
+			},
+			TLS: &routev1.TLSConfig{},
+		},
+	}
+}
+
+func newCommand() *command {
+	return &command{
+		NewRelicLocation:      "AWS_AP_SOUTHEAST_2",
+		KubernetesEvents:      true,
+		KubernetesAnnotations: true,
+		Concurrency:           2,
+		Namespace:             namespace,
+	}
+}
+
+func newClient(t *testing.T, server *fake.Server) *api.Client {
+	client, err := api.New(api.Limits{Retries: 2, Backoff: time.Millisecond}, server.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestSyncCreates(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	kube := kubefake.New(newRoute("web", "web.example.com", nil))
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, ok := server.Monitor("https://web.example.com")
+	if !ok {
+		t.Fatal("expected a monitor to be created")
+	}
+
+	tags := server.Tags(monitor.ID)
+
+	if got := tags[entityutils.TagOpenShiftRouteNamespace]; len(got) != 1 || got[0] != namespace {
+		t.Errorf("expected namespace tag %q, got %v", namespace, got)
+	}
+
+	if got := tags[entityutils.TagOpenShiftRouteName]; len(got) != 1 || got[0] != "web" {
+		t.Errorf("expected name tag %q, got %v", "web", got)
+	}
+
+	route, err := routeutils.Get(kube.Route, namespace, "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := route.ObjectMeta.Annotations[routeutils.AnnotationMonitorID]; got != monitor.ID {
+		t.Errorf("expected monitor ID annotation %q, got %q", monitor.ID, got)
+	}
+
+	if got := route.ObjectMeta.Annotations[routeutils.AnnotationEntityGUID]; got != server.GUID(monitor.ID) {
+		t.Errorf("expected entity GUID annotation %q, got %q", server.GUID(monitor.ID), got)
+	}
+
+	events, err := kube.Core.Events(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events.Items) != 1 {
+		t.Errorf("expected 1 event, got %d", len(events.Items))
+	}
+}
+
+func TestSyncOnlyAnnotatesChanges(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	kube := kubefake.New(newRoute("web", "web.example.com", nil))
+
+	for i := 0; i < 2; i++ {
+		err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var patches int
+
+	for _, action := range kube.Actions() {
+		if action.GetVerb() == "patch" && action.GetResource().Resource == "routes" {
+			patches++
+		}
+	}
+
+	if patches != 1 {
+		t.Errorf("expected the route to be patched once, got %d", patches)
+	}
+}
+
+func TestSyncUpdatesAndReconcilesTags(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	id := server.AddMonitor(synthetics.Monitor{
+		Name:      "https://web.example.com",
+		Type:      synthetics.MonitorTypes.Ping,
+		Frequency: 60,
+		URI:       "https://web.example.com",
+	},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteNamespace, Values: []string{namespace}},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteName, Values: []string{"old"}},
+		entities.Tag{Key: "team", Values: []string{"platform"}},
+	)
+
+	kube := kubefake.New(newRoute("web", "web.example.com", map[string]string{
+		routeutils.AnnotationFrequency: "5",
+	}))
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := server.Requests(fake.OperationCreateMonitor); requests != 0 {
+		t.Errorf("expected no monitors to be created, got %d", requests)
+	}
+
+	monitor, _ := server.Monitor("https://web.example.com")
+	if monitor.ID != id || monitor.Frequency != 5 {
+		t.Errorf("expected monitor %s to be updated with frequency 5, got %+v", id, monitor)
+	}
+
+	tags := server.Tags(id)
+
+	if got := tags[entityutils.TagOpenShiftRouteName]; len(got) != 1 || got[0] != "web" {
+		t.Errorf("expected name tag to be reconciled to %q, got %v", "web", got)
+	}
+
+	if got := tags["team"]; len(got) != 1 || got[0] != "platform" {
+		t.Errorf("expected unmanaged tag to be kept, got %v", got)
+	}
+}
+
+func TestSyncSkipsWhitelist(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	kube := kubefake.New(newRoute("web", "web.example.com", map[string]string{
+		routeutils.AnnotationIPWhitelist: "10.0.0.1",
+	}))
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 0 {
+		t.Errorf("expected no monitors, got %d", len(monitors))
+	}
+}
+
+func TestSyncDryRun(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	kube := kubefake.New(newRoute("web", "web.example.com", nil))
+
+	cmd := newCommand()
+	cmd.DryRun = true
+
+	err := cmd.execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 0 {
+		t.Errorf("expected no monitors, got %d", len(monitors))
+	}
+
+	route, err := routeutils.Get(kube.Route, namespace, "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(route.ObjectMeta.Annotations) != 0 {
+		t.Errorf("expected no annotations, got %v", route.ObjectMeta.Annotations)
+	}
+}
+
+func TestSyncPartialFailure(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	server.Fail(fake.OperationCreateMonitor, http.StatusBadRequest, 1)
+
+	kube := kubefake.New(
+		newRoute("one", "one.example.com", nil),
+		newRoute("two", "two.example.com", nil),
+	)
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+
+	var rerr *report.Error
+	if !errors.As(err, &rerr) {
+		t.Fatalf("expected a report error, got %v", err)
+	}
+
+	if rerr.ExitCode() != report.ExitCodePartial {
+		t.Errorf("expected exit code %d, got %d", report.ExitCodePartial, rerr.ExitCode())
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 1 {
+		t.Errorf("expected 1 monitor, got %d", len(monitors))
+	}
+}
+
+func TestSyncRejectsScriptedTypes(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	// Annotations which were set while the webhook wasn't available.
+	kube := kubefake.New(
+		newRoute("web", "web.example.com", nil),
+		newRoute("scripted", "scripted.example.com", map[string]string{
+			routeutils.AnnotationType: string(synthetics.MonitorTypes.ScriptedBrowser),
+		}),
+	)
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+
+	var rerr *report.Error
+	if !errors.As(err, &rerr) || rerr.ExitCode() != report.ExitCodePartial {
+		t.Fatalf("expected a partial failure, got %v", err)
+	}
+
+	if _, ok := server.Monitor("https://scripted.example.com"); ok {
+		t.Error("expected no monitor to be created for the scripted type")
+	}
+
+	if _, ok := server.Monitor("https://web.example.com"); !ok {
+		t.Error("expected the other route to be synced")
+	}
+}
+
+func TestSyncRejectsStdinOnInterval(t *testing.T) {
+	cmd := &command{
+		RoutesFile: routeutils.Stdin,
+		Interval:   time.Minute,
+	}
+
+	if err := cmd.run(nil); err == nil {
+		t.Fatal("expected stdin to be rejected when running on an interval")
+	}
+}
+
+func TestSyncRetriesRateLimits(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	server.Fail(fake.OperationListMonitors, http.StatusTooManyRequests, 1)
+
+	kube := kubefake.New(newRoute("web", "web.example.com", nil))
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := server.Monitor("https://web.example.com"); !ok {
+		t.Fatal("expected a monitor to be created after retrying")
+	}
+
+	if requests := server.Requests(fake.OperationListMonitors); requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestSyncDoesNotRetryCreates(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	server.Fail(fake.OperationCreateMonitor, http.StatusTooManyRequests, 1)
+
+	kube := kubefake.New(newRoute("web", "web.example.com", nil))
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err == nil {
+		t.Fatal("expected the create to fail")
+	}
+
+	if requests := server.Requests(fake.OperationCreateMonitor); requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+
+	// The next run creates the monitor.
+	err = newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 1 {
+		t.Errorf("expected 1 monitor, got %d", len(monitors))
+	}
+}
+
+func TestSyncRestoresTombstonedMonitors(t *testing.T) {
+	for name, annotations := range map[string]map[string]string{
+		"every field managed": nil,
+		// The status is restored even when it isn't managed, otherwise the monitor would stay disabled.
+		"status not managed": {routeutils.AnnotationManagedFields: "type,locations"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := fake.New(1)
+			defer server.Close()
+
+			id := server.AddMonitor(synthetics.Monitor{
+				Name:   "https://web.example.com",
+				Type:   synthetics.MonitorTypes.Ping,
+				URI:    "https://web.example.com",
+				Status: synthetics.MonitorStatus.Disabled,
+			},
+				entities.Tag{Key: entityutils.TagOpenShiftRouteNamespace, Values: []string{namespace}},
+				entities.Tag{Key: entityutils.TagOpenShiftRouteName, Values: []string{"web"}},
+				entities.Tag{Key: entityutils.TagTombstone, Values: []string{time.Now().UTC().Format(time.RFC3339)}},
+			)
+
+			kube := kubefake.New(newRoute("web", "web.example.com", annotations))
+
+			err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			monitor, _ := server.Monitor("https://web.example.com")
+			if monitor.Status != synthetics.MonitorStatus.Enabled {
+				t.Errorf("expected the monitor to be enabled, got %s", monitor.Status)
+			}
+
+			if tombstone, ok := server.Tags(id)[entityutils.TagTombstone]; ok {
+				t.Errorf("expected the tombstone tag to be removed, got %v", tombstone)
+			}
+		})
+	}
+}
+
+func TestSyncRecordsInventory(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	kube := kubefake.New(newRoute("web", "web.example.com", map[string]string{
+		routeutils.AnnotationAlertPolicyID: "10",
+	}))
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, _ := server.Monitor("https://web.example.com")
+
+	conditions := server.Conditions(10)
+	if len(conditions) != 1 {
+		t.Fatalf("expected 1 alert condition, got %d", len(conditions))
+	}
+
+	want := fmt.Sprintf("alertCondition:10/%d", conditions[0].ID)
+
+	if got := server.Tags(monitor.ID)[entityutils.TagInventory]; len(got) != 1 || got[0] != want {
+		t.Errorf("expected inventory %q, got %v", want, got)
+	}
+
+	// Moving the Route to another policy removes the condition from the previous policy.
+	route, err := routeutils.Get(kube.Route, namespace, "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	route.ObjectMeta.Annotations[routeutils.AnnotationAlertPolicyID] = "20"
+
+	err = kube.Tracker().Update(routev1.SchemeGroupVersion.WithResource("routes"), route, namespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if conditions := server.Conditions(10); len(conditions) != 0 {
+		t.Errorf("expected the previous alert condition to be deleted, got %d", len(conditions))
+	}
+
+	conditions = server.Conditions(20)
+	if len(conditions) != 1 {
+		t.Fatalf("expected 1 alert condition, got %d", len(conditions))
+	}
+
+	want = fmt.Sprintf("alertCondition:20/%d", conditions[0].ID)
+
+	if got := server.Tags(monitor.ID)[entityutils.TagInventory]; len(got) != 1 || got[0] != want {
+		t.Errorf("expected inventory %q, got %v", want, got)
+	}
+}
+
+func TestSyncAdopts(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	id := server.AddMonitor(synthetics.Monitor{
+		Name: "Homepage",
+		Type: synthetics.MonitorTypes.Ping,
+		URI:  "https://web.example.com/",
+	})
+
+	kube := kubefake.New(newRoute("web", "web.example.com", nil))
+
+	cmd := newCommand()
+	cmd.Adopt = true
+
+	err := cmd.execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := server.Requests(fake.OperationCreateMonitor); requests != 0 {
+		t.Errorf("expected no monitors to be created, got %d", requests)
+	}
+
+	monitor, ok := server.Monitor("https://web.example.com")
+	if !ok || monitor.ID != id {
+		t.Fatalf("expected monitor %s to be adopted, got %+v", id, server.Monitors())
+	}
+
+	if got := server.Tags(id)[entityutils.TagOpenShiftRouteName]; len(got) != 1 || got[0] != "web" {
+		t.Errorf("expected name tag %q, got %v", "web", got)
+	}
+}
+
+func TestSyncConsolidatesSharedRoutes(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	kube := kubefake.New(
+		newRoute("web-green", "web.example.com", nil),
+		newRoute("web-blue", "web.example.com", nil),
+	)
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := server.Requests(fake.OperationCreateMonitor); requests != 1 {
+		t.Errorf("expected 1 monitor to be created, got %d", requests)
+	}
+
+	monitor, ok := server.Monitor("https://web.example.com")
+	if !ok {
+		t.Fatal("expected a monitor to be created")
+	}
+
+	tags := server.Tags(monitor.ID)
+
+	want := []string{namespace + "/web-blue", namespace + "/web-green"}
+
+	if got := tags[entityutils.TagOpenShiftRouteOwner]; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("expected owners %v, got %v", want, got)
+	}
+
+	if got := tags[entityutils.TagOpenShiftRouteName]; len(got) != 2 {
+		t.Errorf("expected both Route names to be tagged, got %v", got)
+	}
+
+	for _, name := range []string{"web-blue", "web-green"} {
+		route, err := routeutils.Get(kube.Route, namespace, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := route.ObjectMeta.Annotations[routeutils.AnnotationMonitorID]; got != monitor.ID {
+			t.Errorf("expected %s to be annotated with monitor %q, got %q", name, monitor.ID, got)
+		}
+	}
+}
+
+func TestSyncRefusesConflictingSharedRoutes(t *testing.T) {
+	for name, annotations := range map[string]map[string]string{
+		"settings":  {routeutils.AnnotationFrequency: "5"},
+		"whitelist": {routeutils.AnnotationIPWhitelist: "10.0.0.1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := fake.New(1)
+			defer server.Close()
+
+			kube := kubefake.New(
+				newRoute("web", "web.example.com", nil),
+				newRoute("shared-blue", "shared.example.com", nil),
+				newRoute("shared-green", "shared.example.com", annotations),
+			)
+
+			err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+
+			var rerr *report.Error
+			if !errors.As(err, &rerr) || rerr.ExitCode() != report.ExitCodePartial {
+				t.Fatalf("expected a partial failure, got %v", err)
+			}
+
+			if _, ok := server.Monitor("https://shared.example.com"); ok {
+				t.Error("expected no monitor to be created for the conflicting routes")
+			}
+
+			if _, ok := server.Monitor("https://web.example.com"); !ok {
+				t.Error("expected the other route to be synced")
+			}
+
+			for _, name := range []string{"shared-blue", "shared-green"} {
+				_, err := kube.Core.Events(namespace).Get(context.Background(), name+"."+strings.ToLower(event.ReasonMonitorFailed), metav1.GetOptions{})
+				if err != nil {
+					t.Errorf("expected a failed event for %s: %v", name, err)
+				}
+			}
+		})
+	}
+}
+
+func TestSyncKeepsOwnersFromOtherNamespaces(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	id := server.AddMonitor(synthetics.Monitor{
+		Name:      "https://web.example.com",
+		Type:      synthetics.MonitorTypes.Ping,
+		Frequency: 10,
+		URI:       "https://web.example.com",
+	},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteNamespace, Values: []string{"other"}},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteName, Values: []string{"shard"}},
+		entities.Tag{Key: entityutils.TagOpenShiftRouteOwner, Values: []string{"other/shard"}},
+		entities.Tag{Key: entityutils.TagInventory, Values: []string{"alertCondition:20/5"}},
+	)
+
+	kube := kubefake.New(newRoute("web", "web.example.com", nil))
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := server.Tags(id)
+
+	want := []string{"other/shard", namespace + "/web"}
+
+	if got := tags[entityutils.TagOpenShiftRouteOwner]; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("expected owners %v, got %v", want, got)
+	}
+
+	if got := tags[entityutils.TagOpenShiftRouteNamespace]; len(got) != 2 {
+		t.Errorf("expected both namespaces to be tagged, got %v", got)
+	}
+
+	if got := tags[entityutils.TagInventory]; len(got) != 1 || got[0] != "alertCondition:20/5" {
+		t.Errorf("expected the inventory of the other namespace to be kept, got %v", got)
+	}
+
+	if requests := server.Requests(fake.OperationDeleteCondition); requests != 0 {
+		t.Errorf("expected no conditions to be deleted, got %d", requests)
+	}
+}
+
+func TestSyncDriftPolicies(t *testing.T) {
+	for _, tc := range []struct {
+		policy    drift.Policy
+		tags      []entities.Tag
+		frequency uint
+		reason    string
+	}{
+		{policy: drift.PolicyReport, frequency: 15, reason: event.ReasonMonitorDrifted},
+		{policy: drift.PolicyRevert, frequency: monitorutils.DefaultFrequency, reason: event.ReasonMonitorUpdated},
+		{policy: drift.PolicyAccept, frequency: 15, reason: event.ReasonMonitorUpdated},
+		{
+			policy:    drift.PolicyRevert,
+			tags:      []entities.Tag{{Key: entityutils.TagDriftAccepted, Values: []string{"frequency=1"}}},
+			frequency: 15,
+			reason:    event.ReasonMonitorUpdated,
+		},
+	} {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			server := fake.New(1)
+			defer server.Close()
+
+			route := newRoute("web", "web.example.com", nil)
+
+			id := server.AddMonitor(synthetics.Monitor{
+				Name:         "https://web.example.com",
+				Type:         monitorutils.DefaultType,
+				Frequency:    15,
+				URI:          "https://web.example.com",
+				Locations:    []string{"AWS_AP_SOUTHEAST_2"},
+				Status:       monitorutils.DefaultStatus,
+				SLAThreshold: monitorutils.DefaultSLAThreshold,
+			}, append(entityutils.RouteTags(*route), tc.tags...)...)
+
+			kube := kubefake.New(route)
+
+			cmd := newCommand()
+			cmd.Drift.Policy = string(tc.policy)
+
+			err := cmd.execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			monitor, _ := server.Monitor("https://web.example.com")
+			if monitor.Frequency != tc.frequency {
+				t.Errorf("expected frequency %d, got %d", tc.frequency, monitor.Frequency)
+			}
+
+			if tc.policy == drift.PolicyAccept {
+				if got := server.Tags(id)[entityutils.TagDriftAccepted]; len(got) != 1 || got[0] != "frequency=1" {
+					t.Errorf("expected accepted frequency to be recorded, got %v", got)
+				}
+			}
+
+			events, err := kube.Core.Events(namespace).List(context.Background(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(events.Items) != 1 || events.Items[0].Reason != tc.reason {
+				t.Errorf("expected a %s event, got %+v", tc.reason, events.Items)
+			}
+		})
+	}
+}
+
+func TestSyncDriftReportKeepsClaim(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	route := newRoute("web", "web.example.com", nil)
+
+	heartbeat := time.Now().Add(-45 * time.Minute).UTC().Format(time.RFC3339)
+
+	id := server.AddMonitor(synthetics.Monitor{
+		Name:         "https://web.example.com",
+		Type:         monitorutils.DefaultType,
+		Frequency:    15,
+		URI:          "https://web.example.com",
+		Locations:    []string{"AWS_AP_SOUTHEAST_2"},
+		Status:       monitorutils.DefaultStatus,
+		SLAThreshold: monitorutils.DefaultSLAThreshold,
+	}, append(entityutils.RouteTags(*route),
+		entities.Tag{Key: entityutils.TagClaimCluster, Values: []string{"primary"}},
+		entities.Tag{Key: entityutils.TagClaimHeartbeat, Values: []string{heartbeat}},
+	)...)
+
+	kube := kubefake.New(route)
+
+	cmd := newCommand()
+	cmd.Drift.Policy = string(drift.PolicyReport)
+	cmd.Claims = entityutils.Claims{Cluster: "primary", StaleAfter: time.Hour}
+
+	err := cmd.execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := server.Requests(fake.OperationUpdateMonitor); requests != 0 {
+		t.Errorf("expected the drifted monitor to be left alone, got %d updates", requests)
+	}
+
+	got := server.Tags(id)[entityutils.TagClaimHeartbeat]
+	if len(got) != 1 || got[0] == heartbeat {
+		t.Errorf("expected the heartbeat to advance from %s, got %v", heartbeat, got)
+	}
+}
+
+func TestSyncManagedFields(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	route := newRoute("web", "web.example.com", map[string]string{
+		routeutils.AnnotationManagedFields: "type,locations",
+	})
+
+	server.AddMonitor(synthetics.Monitor{
+		Name:         "https://web.example.com",
+		Type:         synthetics.MonitorTypes.Ping,
+		Frequency:    15,
+		URI:          "https://web.example.com",
+		Locations:    []string{"AWS_US_WEST_1"},
+		Status:       synthetics.MonitorStatus.Muted,
+		SLAThreshold: monitorutils.DefaultSLAThreshold,
+	}, entityutils.RouteTags(*route)...)
+
+	kube := kubefake.New(route)
+
+	err := newCommand().execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, _ := server.Monitor("https://web.example.com")
+
+	if monitor.Type != monitorutils.DefaultType || len(monitor.Locations) != 1 || monitor.Locations[0] != "AWS_AP_SOUTHEAST_2" {
+		t.Errorf("expected managed fields to be updated, got %+v", monitor)
+	}
+
+	if monitor.Frequency != 15 || monitor.Status != synthetics.MonitorStatus.Muted {
+		t.Errorf("expected unmanaged fields to be preserved, got %+v", monitor)
+	}
+}
+
+func TestSyncMonitorCache(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	kube := kubefake.New(newRoute("web", "web.example.com", nil))
+
+	for i := 0; i < 60; i++ {
+		server.AddMonitor(synthetics.Monitor{
+			Name: fmt.Sprintf("https://%03d.example.com", i),
+			Type: monitorutils.DefaultType,
+		})
+	}
+
+	cmd := newCommand()
+
+	for run := 0; run < 2; run++ {
+		var err error
+
+		cmd.monitorCache, err = cache.New(cache.Options{ConfigMap: namespace + "/monitors", FullInterval: time.Hour}, kube.Core, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		before := server.Requests(fake.OperationListMonitors)
+
+		err = cmd.execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		requests := server.Requests(fake.OperationListMonitors) - before
+
+		// The monitor created by the first run is cached, so the second run only lists the last page.
+		if run == 1 && requests != 1 {
+			t.Errorf("expected the second run to list 1 page, got %d", requests)
+		}
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 61 {
+		t.Errorf("expected the monitor for the Route to be created once, got %d monitors", len(monitors))
+	}
+
+	if requests := server.Requests(fake.OperationCreateMonitor); requests != 1 {
+		t.Errorf("expected 1 monitor to be created, got %d", requests)
+	}
+}
+
+func TestSyncPollsForNewMonitors(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	// New monitors are missing from the first few entity queries, as if they had not been indexed yet.
+	server.IndexingLag = 3
+
+	kube := kubefake.New(newRoute("web", "web.example.com", nil))
+
+	cmd := newCommand()
+	cmd.Polling = entityutils.Polling{Timeout: time.Second, Backoff: time.Millisecond}
+	cmd.queue, _ = pending.New(pending.Options{}, nil, namespace)
+
+	err := cmd.execute(newClient(t, server), kube.Route, kube.Core, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, _ := server.Monitor("https://web.example.com")
+
+	if got := server.Tags(monitor.ID)[entityutils.TagOpenShiftRouteNamespace]; len(got) != 1 || got[0] != namespace {
+		t.Errorf("expected the monitor to be tagged once it was indexed, got %v", server.Tags(monitor.ID))
+	}
+
+	if cmd.queue.Len() != 0 {
+		t.Errorf("expected no pending tags, got %d", cmd.queue.Len())
+	}
+}
+
+func TestSyncQueuesPendingTags(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	server.IndexingLag = 1000
+
+	kube := kubefake.New(newRoute("web", "web.example.com", nil))
+
+	cmd := newCommand()
+	cmd.queue, _ = pending.New(pending.Options{ConfigMap: namespace + "/pending"}, kube.Core, namespace)
+
+	rpt := report.New()
+
+	err := cmd.syncSynthetics(newClient(t, server), []routev1.Route{*newRoute("web", "web.example.com", nil)}, nil, nil, rpt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor, _ := server.Monitor("https://web.example.com")
+
+	if tags := server.Tags(monitor.ID); len(tags) != 0 {
+		t.Fatalf("expected the monitor to be untagged, got %v", tags)
+	}
+
+	if results := rpt.Results(); len(results) != 1 || !strings.Contains(results[0].Reason, "pending") {
+		t.Errorf("expected the result to report pending tags, got %+v", results)
+	}
+
+	// The Route is deleted before the next run, which must still tag the monitor so cleanup can find it.
+	err = kube.Tracker().Delete(routev1.SchemeGroupVersion.WithResource("routes"), namespace, "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The next run loads the queue from the ConfigMap.
+	cmd = newCommand()
+	cmd.queue, _ = pending.New(pending.Options{ConfigMap: namespace + "/pending"}, kube.Core, namespace)
+
+	rpt = report.New()
+
+	err = cmd.syncSynthetics(newClient(t, server), nil, nil, nil, rpt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := rpt.Results()
+	if len(results) != 1 || results[0].Action != report.ActionSkipped || !strings.Contains(results[0].Reason, "pending since") {
+		t.Fatalf("expected the monitor to be reported as untagged, got %+v", results)
+	}
+
+	server.Index(monitor.ID)
+
+	rpt = report.New()
+
+	err = cmd.syncSynthetics(newClient(t, server), nil, nil, nil, rpt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results = rpt.Results()
+	if len(results) != 1 || results[0].Action != report.ActionUpdated || results[0].Route != "web" {
+		t.Fatalf("expected the pending tags to be applied, got %+v", results)
+	}
+
+	tags := server.Tags(monitor.ID)
+
+	if got := tags[entityutils.TagOpenShiftRouteName]; len(got) != 1 || got[0] != "web" {
+		t.Errorf("expected the pending tags to be applied, got %v", tags)
+	}
+
+	if cmd.queue.Len() != 0 {
+		t.Errorf("expected the queue to be drained, got %d", cmd.queue.Len())
+	}
+}
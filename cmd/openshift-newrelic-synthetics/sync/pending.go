@@ -0,0 +1,128 @@
+package sync
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/metrics"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/pending"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+)
+
+// Helper function to load the tags which are still pending from previous runs, keyed by monitor name.
+// Monitors which are synced by this run are tagged along with the rest of the run, and monitors which
+// no longer exist are dropped from the queue.
+func (cmd *command) pendingTags(monitors []*synthetics.Monitor, ids map[string]string) map[string]pending.Entry {
+	drain := make(map[string]pending.Entry)
+
+	if cmd.DryRun {
+		return drain
+	}
+
+	entries, err := cmd.queue.Entries()
+	if err != nil {
+		log.WithField("queue", cmd.queue).Warnln("Failed to load pending tags:", err)
+		return drain
+	}
+
+	for _, entry := range entries {
+		logger := log.WithFields(log.Fields{
+			"name": entry.Name,
+			"id":   entry.MonitorID,
+		})
+
+		if id, ok := ids[entry.Name]; ok {
+			if id != entry.MonitorID {
+				cmd.queue.Remove(entry.MonitorID)
+			}
+
+			continue
+		}
+
+		if monitor, ok := monitorutils.Find(monitors, entry.Name); !ok || monitor.ID != entry.MonitorID {
+			logger.Infoln("Dropping pending tags because the monitor no longer exists")
+			cmd.queue.Remove(entry.MonitorID)
+			continue
+		}
+
+		drain[entry.Name] = entry
+	}
+
+	return drain
+}
+
+// Helper function to apply the tags which were queued by a previous run, once the monitor entity has been indexed.
+func (cmd *command) applyPendingTags(client *api.Client, rpt *report.Report, entry pending.Entry, entity *entityutils.Entity) {
+	logger := log.WithFields(log.Fields{
+		"name": entity.Name,
+		"guid": entity.GUID,
+	})
+
+	result := pendingResult(entry)
+	result.GUID = entity.GUID
+	result.Permalink = entity.Permalink
+	result.Tags = entityutils.Replace(entity.Tags, entry.Tags)
+
+	rpt.Add(result)
+
+	logger.Infoln("Applying pending tags")
+
+	_, err := entityutils.ReconcileTags(client, entity.GUID, entity.Tags, result.Tags)
+	if err != nil {
+		logger.Errorln("Failed to apply pending tags:", err)
+		result.Action = report.ActionFailed
+		result.Error = fmt.Errorf("failed to apply pending tags: %w", err)
+		return
+	}
+
+	result.Action = report.ActionUpdated
+	result.Reason = fmt.Sprintf("applied tags which were pending since %s", entry.QueuedAt.Format(time.RFC3339))
+
+	cmd.queue.Remove(entry.MonitorID)
+}
+
+// Helper function to report a monitor from a previous run which is still untagged.
+func (cmd *command) untagged(rpt *report.Report, entry pending.Entry) {
+	log.WithFields(log.Fields{
+		"name":   entry.Name,
+		"id":     entry.MonitorID,
+		"queued": entry.QueuedAt,
+	}).Warnln("Monitor is still untagged because its entity has not been indexed")
+
+	result := pendingResult(entry)
+	result.Action = report.ActionSkipped
+	result.Reason = fmt.Sprintf("tags have been pending since %s because the monitor entity has not been indexed", entry.QueuedAt.Format(time.RFC3339))
+
+	rpt.Add(result)
+}
+
+// Helper function to save the pending tags so the next run can drain them.
+func (cmd *command) savePendingTags() {
+	if cmd.DryRun {
+		return
+	}
+
+	err := cmd.queue.Save()
+	if err != nil {
+		log.WithField("queue", cmd.queue).Warnln("Failed to save pending tags:", err)
+	}
+
+	metrics.Untagged.WithLabelValues(cmd.Namespace).Set(float64(cmd.queue.Len()))
+}
+
+// Helper function to build the result for a monitor with pending tags.
+func pendingResult(entry pending.Entry) *report.Result {
+	return &report.Result{
+		Namespace: entry.Namespace,
+		Route:     entry.Route,
+		URL:       entry.Name,
+		Monitor:   entry.Name,
+		MonitorID: entry.MonitorID,
+	}
+}
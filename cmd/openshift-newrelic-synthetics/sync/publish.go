@@ -0,0 +1,96 @@
+package sync
+
+import (
+	"fmt"
+
+	routev1 "github.com/openshift/api/route/v1"
+	routeclient "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/event"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/worker"
+)
+
+// Helper function to publish the result of a sync back to each Route as Kubernetes Events and annotations.
+// Failures are logged rather than failing the sync, the monitors themselves are the source of truth.
+// Annotations only describe the monitor so a Route is only patched when its monitor changes, the time of
+// the last sync is recorded by the Event instead.
+func (cmd *command) publish(routeClient routeclient.RoutesGetter, coreClient coreclient.EventsGetter, routes []routev1.Route, rpt *report.Report) error {
+	// There is no cluster to publish to when Routes are loaded from files.
+	if cmd.DryRun || cmd.RoutesFile != "" {
+		return nil
+	}
+
+	index := make(map[string]routev1.Route, len(routes))
+
+	for _, route := range routes {
+		index[route.ObjectMeta.Namespace+"/"+route.ObjectMeta.Name] = route
+	}
+
+	pool := worker.New(cmd.Concurrency)
+
+	for _, result := range rpt.Results() {
+		result := result
+
+		route, ok := index[result.Namespace+"/"+result.Route]
+		if !ok {
+			continue
+		}
+
+		pool.Go(func() error {
+			logger := log.WithFields(log.Fields{
+				"namespace": route.ObjectMeta.Namespace,
+				"name":      route.ObjectMeta.Name,
+			})
+
+			if cmd.KubernetesEvents {
+				eventType, reason, message := eventFor(result)
+
+				err := event.Record(coreClient, routeutils.Reference(route), eventType, reason, message)
+				if err != nil {
+					logger.Warnln("Failed to record event:", err)
+				}
+			}
+
+			if cmd.KubernetesAnnotations && result.Error == nil && result.MonitorID != "" {
+				annotations := map[string]string{
+					routeutils.AnnotationMonitorID: result.MonitorID,
+				}
+
+				if result.GUID != "" {
+					annotations[routeutils.AnnotationEntityGUID] = result.GUID
+					annotations[routeutils.AnnotationMonitorURL] = result.Permalink
+				}
+
+				err := routeutils.Annotate(routeClient, route, annotations)
+				if err != nil {
+					logger.Warnln("Failed to annotate route:", err)
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return pool.Wait()
+}
+
+// Helper function to describe a result as a Kubernetes Event.
+func eventFor(result *report.Result) (string, string, string) {
+	switch {
+	case result.Error != nil:
+		return corev1.EventTypeWarning, event.ReasonMonitorFailed, fmt.Sprintf("Failed to sync monitor for %s: %s", result.URL, result.Error)
+	case result.Action == report.ActionCreated:
+		return corev1.EventTypeNormal, event.ReasonMonitorCreated, fmt.Sprintf("Created monitor %s for %s", result.MonitorID, result.URL)
+	case result.Action == report.ActionUpdated:
+		return corev1.EventTypeNormal, event.ReasonMonitorUpdated, fmt.Sprintf("Updated monitor %s for %s", result.MonitorID, result.URL)
+	case result.Action == report.ActionDrifted:
+		return corev1.EventTypeWarning, event.ReasonMonitorDrifted, fmt.Sprintf("Monitor %s for %s has drifted: %s", result.MonitorID, result.URL, result.Reason)
+	default:
+		return corev1.EventTypeNormal, event.ReasonMonitorSkipped, fmt.Sprintf("Skipped monitor for %s: %s", result.URL, result.Reason)
+	}
+}
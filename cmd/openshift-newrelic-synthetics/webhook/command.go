@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/account"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/webhook"
+)
+
+type command struct {
+	NewRelicAccount account.Account
+	NewRelicLimits  api.Limits
+	Addr            string
+	TLSCertFile     string
+	TLSKeyFile      string
+}
+
+func (cmd *command) run(c *kingpin.ParseContext) error {
+	locations, err := cmd.locations()
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+
+	mux.Handle("/validate/routes", webhook.Handler(webhook.Route(locations)))
+	mux.Handle("/validate/syntheticspolicies", webhook.Handler(webhook.SyntheticsPolicy(locations)))
+	mux.Handle("/validate/syntheticmonitors", webhook.Handler(webhook.SyntheticMonitor(locations)))
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	log.Infoln("Serving webhook on:", cmd.Addr)
+
+	return http.ListenAndServeTLS(cmd.Addr, cmd.TLSCertFile, cmd.TLSKeyFile, mux)
+}
+
+// Helper function to load the locations known to New Relic.
+// Locations are not validated if an API key has not been provided.
+func (cmd *command) locations() ([]string, error) {
+	if !cmd.NewRelicAccount.HasAPIKey() {
+		log.Infoln("Locations will not be validated because an API key was not provided")
+		return nil, nil
+	}
+
+	acc, err := cmd.NewRelicAccount.LoadAPIKey(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := acc.Client(cmd.NewRelicLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	return monitorutils.Locations(client)
+}
+
+// Command which serves a validating admission webhook.
+func Command(app *kingpin.Application) {
+	c := new(command)
+
+	command := app.Command("webhook", "Serve a validating admission webhook for Route annotations and custom resources").Action(c.run)
+
+	// An API key is optional, it is only used to load the list of known locations.
+	c.NewRelicAccount.Flags(command)
+	c.NewRelicLimits.Flags(command)
+
+	command.Flag("addr", "Address to serve the webhook on").Envar("WEBHOOK_ADDR").Default(":8443").StringVar(&c.Addr)
+	command.Flag("tls-cert-file", "Path to the TLS certificate").Envar("WEBHOOK_TLS_CERT_FILE").Required().StringVar(&c.TLSCertFile)
+	command.Flag("tls-key-file", "Path to the TLS private key").Envar("WEBHOOK_TLS_KEY_FILE").Required().StringVar(&c.TLSKeyFile)
+}
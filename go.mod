@@ -2,15 +2,21 @@ module github.com/codedropau/openshift-newrelic-synthetics
 
 go 1.14
 
-replace github.com/newrelic/newrelic-client-go => github.com/nickschuch/newrelic-client-go v0.50.1-0.20201124011817-0a6479b171fc
+// Copy of github.com/nickschuch/newrelic-client-go v0.50.1-0.20201124011817-0a6479b171fc with options to configure
+// the client's retries, which are made by internal/newrelic/api instead.
+replace github.com/newrelic/newrelic-client-go => ./third_party/newrelic-client-go
 
 require (
 	github.com/gophercloud/gophercloud v0.1.0 // indirect
+	github.com/hashicorp/go-retryablehttp v0.6.8
 	github.com/newrelic/newrelic-client-go v0.50.0
-	github.com/openshift/api v3.9.0+incompatible // indirect
+	github.com/openshift/api v3.9.0+incompatible
 	github.com/openshift/client-go v0.0.0-20201020082437-7737f16e53fc
+	github.com/prometheus/client_golang v1.8.0
 	github.com/sirupsen/logrus v1.7.0
+	golang.org/x/time v0.0.0-20191024005414-555d28b269f0
 	gopkg.in/alecthomas/kingpin.v2 v2.2.6
+	k8s.io/api v0.19.2
 	k8s.io/apimachinery v0.19.2
 	k8s.io/client-go v0.19.2
 	k8s.io/klog v1.0.0 // indirect
@@ -0,0 +1,203 @@
+// Package adopt matches monitors which were created by hand to Routes, so they can be brought under management
+// instead of being duplicated. Adopted monitors keep their ID, which preserves their history and alert conditions.
+package adopt
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	routev1 "github.com/openshift/api/route/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+)
+
+// Adoption proposed for a Route which does not have a monitor yet.
+type Adoption struct {
+	Route routev1.Route
+	// URL which the adopted monitor will be renamed to.
+	URL string
+	// Candidates are the unmanaged monitors which match the Route.
+	Candidates []*synthetics.Monitor
+	// Shared is true when the candidate also matches another Route.
+	Shared bool
+}
+
+// Monitor which will be adopted, only when exactly one monitor matches.
+func (a Adoption) Monitor() (*synthetics.Monitor, bool) {
+	if len(a.Candidates) != 1 || a.Shared {
+		return nil, false
+	}
+
+	return a.Candidates[0], true
+}
+
+// Reason an adoption can't go ahead.
+func (a Adoption) Reason() string {
+	if len(a.Candidates) > 1 {
+		return fmt.Sprintf("%d unmanaged monitors match the route", len(a.Candidates))
+	}
+
+	if a.Shared {
+		return "monitor matches more than one route"
+	}
+
+	return ""
+}
+
+// Plan the adoptions for Routes which do not have a monitor, matching unmanaged monitors by normalised URI.
+func Plan(client *api.Client, monitors []*synthetics.Monitor, routes []routev1.Route) ([]Adoption, error) {
+	index := make(map[string][]*synthetics.Monitor)
+
+	for _, monitor := range monitors {
+		if uri := monitorutils.NormaliseURI(monitor.URI); uri != "" {
+			index[uri] = append(index[uri], monitor)
+		}
+	}
+
+	var (
+		adoptions []Adoption
+		names     []string
+	)
+
+	seen := make(map[string]bool, len(routes))
+
+	for _, route := range routes {
+		uri := routeutils.URL(route)
+
+		// Routes which share a URL are merged into a single monitor, so they don't compete for the same candidate.
+		if seen[uri.String()] {
+			continue
+		}
+
+		seen[uri.String()] = true
+
+		if _, ok := monitorutils.Exists(monitors, uri.String()); ok {
+			continue
+		}
+
+		candidates := index[monitorutils.NormaliseURI(uri.String())]
+		if len(candidates) == 0 {
+			continue
+		}
+
+		adoptions = append(adoptions, Adoption{
+			Route:      route,
+			URL:        uri.String(),
+			Candidates: candidates,
+		})
+
+		for _, candidate := range candidates {
+			names = append(names, candidate.Name)
+		}
+	}
+
+	if len(adoptions) == 0 {
+		return nil, nil
+	}
+
+	// Monitors which are already managed eg. provisioned for another Route or a SyntheticMonitor are not adopted.
+	managed := make(map[string]bool)
+
+	list, err := entityutils.SearchNames(client, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup monitor tags: %w", err)
+	}
+
+	for _, entity := range list {
+		if !entityutils.Managed(entity.Tags) {
+			continue
+		}
+
+		if id, err := entityutils.MonitorID(entity.GUID); err == nil {
+			managed[id] = true
+		}
+	}
+
+	var plan []Adoption
+
+	matches := make(map[string]int)
+
+	for _, adoption := range adoptions {
+		var candidates []*synthetics.Monitor
+
+		for _, candidate := range adoption.Candidates {
+			if !managed[candidate.ID] {
+				candidates = append(candidates, candidate)
+			}
+		}
+
+		if len(candidates) == 0 {
+			continue
+		}
+
+		adoption.Candidates = candidates
+
+		for _, candidate := range candidates {
+			matches[candidate.ID]++
+		}
+
+		plan = append(plan, adoption)
+	}
+
+	for i := range plan {
+		for _, candidate := range plan[i].Candidates {
+			if matches[candidate.ID] > 1 {
+				plan[i].Shared = true
+			}
+		}
+	}
+
+	sort.Slice(plan, func(i, j int) bool {
+		return plan[i].URL < plan[j].URL
+	})
+
+	return plan, nil
+}
+
+// Rename the adopted monitor so it is matched to its Route from now on. The monitor was matched by its normalised URI,
+// so the URI is also set to the Route URL eg. a monitor for "http://WEB.example.com:80/" checks the Route it now belongs to.
+func Rename(client *api.Client, monitor *synthetics.Monitor, url string) error {
+	renamed := *monitor
+	renamed.Name = url
+	renamed.URI = url
+
+	err := client.Call("Synthetics.UpdateMonitor", func() error {
+		_, err := client.Synthetics.UpdateMonitor(renamed)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	monitor.Name = url
+	monitor.URI = url
+
+	return nil
+}
+
+// Print the proposed adoptions as a table.
+func Print(w io.Writer, plan []Adoption) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "NAMESPACE\tROUTE\tMONITOR ID\tCURRENT NAME\tNEW NAME")
+
+	for _, adoption := range plan {
+		for _, candidate := range adoption.Candidates {
+			name := adoption.URL
+
+			if reason := adoption.Reason(); reason != "" {
+				name = "(skipped, " + reason + ")"
+			}
+
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", adoption.Route.ObjectMeta.Namespace, adoption.Route.ObjectMeta.Name, candidate.ID, candidate.Name, name)
+		}
+	}
+
+	return tw.Flush()
+}
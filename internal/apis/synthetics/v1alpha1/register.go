@@ -0,0 +1,17 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// GroupName of the custom resources owned by this tool.
+const GroupName = "synthetics.codedrop.com.au"
+
+// SchemeGroupVersion of the custom resources owned by this tool.
+var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+
+// SyntheticMonitorResource used when querying SyntheticMonitors.
+var SyntheticMonitorResource = SchemeGroupVersion.WithResource("syntheticmonitors")
+
+// SyntheticsPolicyResource used when querying SyntheticsPolicies.
+var SyntheticsPolicyResource = SchemeGroupVersion.WithResource("syntheticspolicies")
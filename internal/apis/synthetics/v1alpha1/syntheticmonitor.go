@@ -0,0 +1,103 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyntheticMonitorKind is the kind of the SyntheticMonitor custom resource.
+const SyntheticMonitorKind = "SyntheticMonitor"
+
+const (
+	// ConditionReady is set once the monitor has been provisioned in New Relic.
+	ConditionReady = "Ready"
+)
+
+// SyntheticMonitor describes a New Relic Synthetics monitor which is not tied one-to-one with a Route
+// eg. deep health endpoints, multiple paths for a Route or scripted journeys.
+type SyntheticMonitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SyntheticMonitorSpec   `json:"spec"`
+	Status SyntheticMonitorStatus `json:"status,omitempty"`
+}
+
+// SyntheticMonitorSpec is the desired state of a monitor.
+type SyntheticMonitorSpec struct {
+	// Target which will be monitored.
+	Target Target `json:"target"`
+	// Name of the monitor, which is always prefixed with the namespace eg. "<namespace>/<spec.name>". Defaults to "<namespace>/<name>".
+	Name string `json:"name,omitempty"`
+	// Type of monitor eg. SIMPLE, BROWSER, SCRIPT_BROWSER or SCRIPT_API.
+	Type string `json:"type,omitempty"`
+	// Frequency in minutes which the monitor runs.
+	Frequency uint `json:"frequency,omitempty"`
+	// Locations which the monitor runs from.
+	Locations []string `json:"locations,omitempty"`
+	// SLAThreshold in seconds.
+	SLAThreshold float64 `json:"slaThreshold,omitempty"`
+	// Status of the monitor eg. ENABLED, MUTED or DISABLED.
+	Status string `json:"status,omitempty"`
+	// Options for SIMPLE and BROWSER monitors.
+	Options Options `json:"options,omitempty"`
+	// Script for SCRIPT_BROWSER and SCRIPT_API monitors.
+	Script *ScriptReference `json:"script,omitempty"`
+	// Alerts which will be raised for the monitor.
+	Alerts *AlertSettings `json:"alerts,omitempty"`
+}
+
+// Target which will be monitored, either a Route or a URL.
+type Target struct {
+	// Route in the same namespace.
+	Route *RouteTarget `json:"route,omitempty"`
+	// URL which is monitored directly.
+	URL string `json:"url,omitempty"`
+}
+
+// RouteTarget monitors a path on a Route.
+type RouteTarget struct {
+	// Name of the Route.
+	Name string `json:"name"`
+	// Path which replaces the Route's path eg. a deep health endpoint.
+	Path string `json:"path,omitempty"`
+}
+
+// Options for SIMPLE and BROWSER monitors.
+type Options struct {
+	ValidationString       string `json:"validationString,omitempty"`
+	VerifySSL              bool   `json:"verifySSL,omitempty"`
+	BypassHEADRequest      bool   `json:"bypassHEADRequest,omitempty"`
+	TreatRedirectAsFailure bool   `json:"treatRedirectAsFailure,omitempty"`
+}
+
+// ScriptReference to a ConfigMap key in the same namespace which contains the monitor script.
+type ScriptReference struct {
+	ConfigMap string `json:"configMap"`
+	Key       string `json:"key"`
+}
+
+// AlertSettings for raising alerts when the monitor fails.
+type AlertSettings struct {
+	// PolicyID of the alert policy which the condition is added to.
+	PolicyID int `json:"policyId"`
+	// RunbookURL attached to the alert condition.
+	RunbookURL string `json:"runbookURL,omitempty"`
+	// Enabled toggles the alert condition, defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// SyntheticMonitorStatus is the observed state of a monitor.
+type SyntheticMonitorStatus struct {
+	// ObservedGeneration which the status reflects.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// MonitorID of the provisioned monitor.
+	MonitorID string `json:"monitorID,omitempty"`
+	// GUID of the monitor entity.
+	GUID string `json:"guid,omitempty"`
+	// ConditionID of the alert condition.
+	ConditionID int `json:"conditionID,omitempty"`
+	// URL which is being monitored.
+	URL string `json:"url,omitempty"`
+	// Conditions describing the state of the monitor.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,46 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyntheticsPolicyKind is the kind of the SyntheticsPolicy custom resource.
+const SyntheticsPolicyKind = "SyntheticsPolicy"
+
+// SyntheticsPolicy is a cluster scoped set of monitor defaults for the Routes which it selects.
+type SyntheticsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SyntheticsPolicySpec `json:"spec"`
+}
+
+// SyntheticsPolicySpec selects Routes and declares defaults for their monitors.
+type SyntheticsPolicySpec struct {
+	// Priority of the policy when more than one policy selects a Route. Higher priorities win.
+	Priority int `json:"priority,omitempty"`
+	// NamespaceSelector which selects the namespaces of Routes. An empty selector selects every namespace.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// RouteSelector which selects Routes. An empty selector selects every Route.
+	RouteSelector *metav1.LabelSelector `json:"routeSelector,omitempty"`
+	// Defaults applied to the monitors of selected Routes.
+	Defaults PolicyDefaults `json:"defaults"`
+}
+
+// PolicyDefaults for monitors. Only the fields which are set are applied.
+type PolicyDefaults struct {
+	// Enabled toggles whether selected Routes are monitored.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Type of monitor eg. SIMPLE or BROWSER.
+	Type string `json:"type,omitempty"`
+	// Frequency in minutes which the monitor runs.
+	Frequency uint `json:"frequency,omitempty"`
+	// Locations which the monitor runs from.
+	Locations []string `json:"locations,omitempty"`
+	// SLAThreshold in seconds.
+	SLAThreshold float64 `json:"slaThreshold,omitempty"`
+	// AlertPolicyID of the alert policy which a condition for the monitor is added to.
+	AlertPolicyID int `json:"alertPolicyId,omitempty"`
+	// ManagedFields of the monitor which are kept in sync, the rest can be tuned in the New Relic UI. Every field is managed when empty.
+	ManagedFields []string `json:"managedFields,omitempty"`
+}
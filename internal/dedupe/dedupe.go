@@ -0,0 +1,315 @@
+// Package dedupe finds managed monitors which duplicate each other, either because they have the same owner
+// or check the same URL without an owner, and picks which of them survives.
+package dedupe
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/inventory"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+)
+
+// Rule which picks the monitor which survives.
+type Rule string
+
+const (
+	// RuleOldest keeps the monitor which was created first, it has the longest history.
+	RuleOldest Rule = "oldest"
+	// RuleNewest keeps the monitor which was modified most recently.
+	RuleNewest Rule = "newest"
+	// RuleConditions keeps a monitor which has alert conditions, falling back to the oldest.
+	RuleConditions Rule = "conditions"
+)
+
+// Rules which can be used to pick a survivor.
+var Rules = []string{
+	string(RuleOldest),
+	string(RuleNewest),
+	string(RuleConditions),
+}
+
+// Member of a group of duplicates.
+type Member struct {
+	Monitor *synthetics.Monitor
+	Entity  *entityutils.Entity
+}
+
+// Group of monitors which duplicate each other.
+type Group struct {
+	// Key which the monitors share, either an owner or a normalised URL.
+	Key     string
+	Members []Member
+}
+
+// Find groups of managed monitors which have the same owner, or check the same URL without an owner.
+func Find(monitors []*synthetics.Monitor, list []*entityutils.Entity) []Group {
+	byID := make(map[string]*synthetics.Monitor, len(monitors))
+
+	for _, monitor := range monitors {
+		byID[monitor.ID] = monitor
+	}
+
+	var members []Member
+
+	seen := make(map[string]bool)
+
+	for _, entity := range list {
+		if !entityutils.Managed(entity.Tags) {
+			continue
+		}
+
+		id, err := entityutils.MonitorID(entity.GUID)
+		if err != nil || seen[id] {
+			continue
+		}
+
+		monitor, ok := byID[id]
+		if !ok {
+			continue
+		}
+
+		seen[id] = true
+
+		members = append(members, Member{
+			Monitor: monitor,
+			Entity:  entity,
+		})
+	}
+
+	// Members are joined into a group when they share any of their keys.
+	parent := make([]int, len(members))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var root func(int) int
+	root = func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+
+		return i
+	}
+
+	owners := make(map[string]int)
+
+	for i, member := range members {
+		for _, key := range keys(member) {
+			if j, ok := owners[key]; ok {
+				parent[root(i)] = root(j)
+				continue
+			}
+
+			owners[key] = i
+		}
+	}
+
+	grouped := make(map[int]*Group)
+
+	for i, member := range members {
+		r := root(i)
+
+		if _, ok := grouped[r]; !ok {
+			grouped[r] = &Group{
+				Key: keys(members[r])[0],
+			}
+		}
+
+		grouped[r].Members = append(grouped[r].Members, member)
+	}
+
+	var groups []Group
+
+	for _, group := range grouped {
+		if len(group.Members) < 2 {
+			continue
+		}
+
+		sort.Slice(group.Members, func(i, j int) bool {
+			return group.Members[i].Monitor.ID < group.Members[j].Monitor.ID
+		})
+
+		groups = append(groups, *group)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Key < groups[j].Key
+	})
+
+	return groups
+}
+
+// Survivor of the group according to a rule, followed by the extras which duplicate it.
+// Monitors named after the current URL of their Route are always preferred, so a monitor left behind
+// by a Route which changed its host never survives over the monitor for the new host.
+func (g Group) Survivor(rule Rule, current map[string]bool) (Member, []Member) {
+	members := append([]Member{}, g.Members...)
+
+	sort.SliceStable(members, func(i, j int) bool {
+		a, b := members[i], members[j]
+
+		if current[a.Monitor.Name] != current[b.Monitor.Name] {
+			return current[a.Monitor.Name]
+		}
+
+		switch rule {
+		case RuleNewest:
+			if !modified(a).Equal(modified(b)) {
+				return modified(a).After(modified(b))
+			}
+		case RuleConditions:
+			if hasConditions(a) != hasConditions(b) {
+				return hasConditions(a)
+			}
+		}
+
+		// Monitors without a creation time are treated as the newest.
+		if (a.Monitor.CreatedAt == nil) != (b.Monitor.CreatedAt == nil) {
+			return b.Monitor.CreatedAt == nil
+		}
+
+		if !created(a).Equal(created(b)) {
+			return created(a).Before(created(b))
+		}
+
+		return a.Monitor.ID < b.Monitor.ID
+	})
+
+	return members[0], members[1:]
+}
+
+// Names of monitors which are shared by more than one monitor, along with the number of monitors.
+// This is cheap enough to check during every sync because it only needs the list of monitors.
+func Names(monitors []*synthetics.Monitor) map[string]int {
+	counts := make(map[string]int)
+
+	for _, monitor := range monitors {
+		counts[monitor.Name]++
+	}
+
+	for name, count := range counts {
+		if count < 2 {
+			delete(counts, name)
+		}
+	}
+
+	return counts
+}
+
+// UnmanagedTags on the extras which are missing from the survivor, so they can be copied across.
+func UnmanagedTags(survivor Member, extras []Member) []entities.Tag {
+	have := make(map[string]bool)
+
+	for _, tag := range survivor.Entity.Tags {
+		if tag == nil {
+			continue
+		}
+
+		for _, value := range tag.Values {
+			have[tag.Key+"="+value] = true
+		}
+	}
+
+	var (
+		tags  []entities.Tag
+		index = make(map[string]int)
+	)
+
+	for _, extra := range extras {
+		for _, tag := range extra.Entity.Tags {
+			if tag == nil || contains(entityutils.ManagedTags, tag.Key) || contains(entityutils.DoNotDeleteTags, tag.Key) {
+				continue
+			}
+
+			for _, value := range tag.Values {
+				if have[tag.Key+"="+value] {
+					continue
+				}
+
+				have[tag.Key+"="+value] = true
+
+				i, ok := index[tag.Key]
+				if !ok {
+					i = len(tags)
+					index[tag.Key] = i
+					tags = append(tags, entities.Tag{Key: tag.Key})
+				}
+
+				tags[i].Values = append(tags[i].Values, value)
+			}
+		}
+	}
+
+	return tags
+}
+
+// Helper function to list the keys which identify a monitor. The owner is listed first when it is known.
+func keys(member Member) []string {
+	var list []string
+
+	for _, owner := range entityutils.Owners(member.Entity.Tags) {
+		list = append(list, "route/"+owner.String())
+	}
+
+	if namespace, ok := entityutils.TagValue(member.Entity.Tags, entityutils.TagSyntheticMonitorNamespace); ok {
+		name, _ := entityutils.TagValue(member.Entity.Tags, entityutils.TagSyntheticMonitorName)
+		list = append(list, fmt.Sprintf("syntheticmonitor/%s/%s", namespace, name))
+	}
+
+	// Monitors with different owners may check the same URL on purpose, eg. two SyntheticMonitors
+	// or a SyntheticMonitor and a Route, so the URL only groups monitors which don't have an owner.
+	// Monitors which share an owner are already grouped by it.
+	if uri := monitorutils.NormaliseURI(member.Monitor.URI); uri != "" && len(list) == 0 {
+		list = append(list, "url/"+uri)
+	}
+
+	// Scripted monitors without a URL or owner are only matched by name.
+	return append(list, "name/"+member.Monitor.Name)
+}
+
+// Helper function to get when a monitor was created.
+func created(member Member) time.Time {
+	if member.Monitor.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return time.Time(*member.Monitor.CreatedAt)
+}
+
+// Helper function to get when a monitor was last modified.
+func modified(member Member) time.Time {
+	if member.Monitor.ModifiedAt == nil {
+		return time.Time{}
+	}
+
+	return time.Time(*member.Monitor.ModifiedAt)
+}
+
+// Helper function to check if a monitor has alert conditions recorded in its inventory.
+func hasConditions(member Member) bool {
+	for _, item := range inventory.FromTags(member.Entity.Tags) {
+		if item.Kind == inventory.KindAlertCondition {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper function to check if a list contains a value.
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,69 @@
+package dedupe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+)
+
+// Helper function to build a managed monitor and its entity.
+func member(id, uri string, tags ...*entities.Tag) (*synthetics.Monitor, *entityutils.Entity) {
+	monitor := &synthetics.Monitor{
+		ID:   id,
+		Name: fmt.Sprintf("%s (%s)", uri, id),
+		URI:  uri,
+	}
+
+	entity := &entityutils.Entity{
+		GUID: entityutils.GUID(1, id),
+		Tags: tags,
+	}
+
+	return monitor, entity
+}
+
+func TestFindSharedURL(t *testing.T) {
+	route := func(namespace, name string) []*entities.Tag {
+		return []*entities.Tag{
+			{Key: entityutils.TagOpenShiftRouteNamespace, Values: []string{namespace}},
+			{Key: entityutils.TagOpenShiftRouteName, Values: []string{name}},
+		}
+	}
+
+	crd := func(namespace, name string) []*entities.Tag {
+		return []*entities.Tag{
+			{Key: entityutils.TagSyntheticMonitorNamespace, Values: []string{namespace}},
+			{Key: entityutils.TagSyntheticMonitorName, Values: []string{name}},
+		}
+	}
+
+	// Monitors which were tagged with a namespace but never recorded who owns them.
+	orphan := []*entities.Tag{
+		{Key: entityutils.TagOpenShiftRouteNamespace, Values: []string{"test"}},
+	}
+
+	tests := []struct {
+		name   string
+		a, b   []*entities.Tag
+		groups int
+	}{
+		{name: "two SyntheticMonitors", a: crd("test", "home"), b: crd("test", "login"), groups: 0},
+		{name: "a SyntheticMonitor and a Route", a: crd("test", "home"), b: route("test", "web"), groups: 0},
+		{name: "the same Route", a: route("test", "web"), b: route("test", "web"), groups: 1},
+		{name: "no owner", a: orphan, b: orphan, groups: 1},
+	}
+
+	for _, test := range tests {
+		first, a := member("1", "https://web.example.com", test.a...)
+		second, b := member("2", "http://web.example.com/", test.b...)
+
+		groups := Find([]*synthetics.Monitor{first, second}, []*entityutils.Entity{a, b})
+		if len(groups) != test.groups {
+			t.Errorf("%s: expected %d groups, got %+v", test.name, test.groups, groups)
+		}
+	}
+}
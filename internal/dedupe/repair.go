@@ -0,0 +1,293 @@
+package dedupe
+
+import (
+	"fmt"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/inventory"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+)
+
+// Action taken for the extras in a group.
+type Action string
+
+const (
+	// ActionDelete deletes the extras along with the objects in their inventory which were not migrated.
+	ActionDelete Action = "delete"
+	// ActionDisable disables and renames the extras, and removes the tags which mark them as managed.
+	ActionDisable Action = "disable"
+)
+
+// Actions which can be taken for the extras.
+var Actions = []string{
+	string(ActionDelete),
+	string(ActionDisable),
+}
+
+// Options for repairing a group.
+type Options struct {
+	Rule   Rule
+	Action Action
+	DryRun bool
+	// Current names of monitors which match the URL of their Route.
+	Current map[string]bool
+}
+
+// Repair a group by migrating alert conditions and tags from the extras to the survivor, then removing the extras.
+// Every step is added to the report.
+func Repair(client *api.Client, rpt *report.Report, group Group, opts Options) {
+	survivor, extras := group.Survivor(opts.Rule, opts.Current)
+
+	logger := log.WithFields(log.Fields{
+		"key":      group.Key,
+		"survivor": survivor.Monitor.ID,
+	})
+
+	result := newResult(survivor)
+	result.Action = report.ActionSkipped
+	result.Reason = fmt.Sprintf("survivor of %d duplicates picked by the %s rule", len(group.Members), opts.Rule)
+
+	rpt.Add(result)
+
+	items := inventory.FromTags(survivor.Entity.Tags)
+
+	policies := make(map[int]bool)
+
+	for _, item := range items {
+		if policyID, _, err := item.AlertCondition(); err == nil {
+			policies[policyID] = true
+		}
+	}
+
+	migrated := make(map[string]map[inventory.Item]bool, len(extras))
+	failed := make(map[string]bool)
+
+	for _, extra := range extras {
+		migrated[extra.Monitor.ID] = make(map[inventory.Item]bool)
+
+		for _, item := range inventory.FromTags(extra.Entity.Tags) {
+			policyID, conditionID, err := item.AlertCondition()
+
+			// Conditions for a policy which the survivor is already in would alert twice, so they are removed with the extra.
+			if err != nil || policyID == 0 || policies[policyID] {
+				continue
+			}
+
+			child := newResult(extra)
+			child.Object = item.String()
+
+			rpt.Add(child)
+
+			if opts.DryRun {
+				logger.Infof("Dry run is enabled. %s would have been migrated from %s.", item, extra.Monitor.ID)
+				child.Action = report.ActionDryRun
+				child.Reason = fmt.Sprintf("would have been migrated to %s", survivor.Monitor.ID)
+				policies[policyID] = true
+				continue
+			}
+
+			err = migrate(client, policyID, conditionID, survivor.Monitor)
+			if err != nil {
+				logger.Errorf("Failed to migrate %s from %s: %s", item, extra.Monitor.ID, err)
+				child.Action = report.ActionFailed
+				child.Error = fmt.Errorf("failed to migrate alert condition: %w", err)
+				failed[extra.Monitor.ID] = true
+				continue
+			}
+
+			child.Action = report.ActionUpdated
+			child.Reason = fmt.Sprintf("migrated to %s", survivor.Monitor.ID)
+
+			items = append(items, item)
+			policies[policyID] = true
+			migrated[extra.Monitor.ID][item] = true
+		}
+	}
+
+	unmanaged := UnmanagedTags(survivor, extras)
+
+	if !opts.DryRun {
+		err := retag(client, survivor, items, unmanaged)
+		if err != nil {
+			logger.Errorln("Failed to tag survivor:", err)
+			result.Action = report.ActionFailed
+			result.Error = fmt.Errorf("failed to tag survivor: %w", err)
+
+			// The migrated conditions are only recorded on the extras, so they must not be removed.
+			return
+		}
+
+		if len(unmanaged) > 0 || len(items) > len(inventory.FromTags(survivor.Entity.Tags)) {
+			result.Action = report.ActionUpdated
+		}
+	}
+
+	for _, extra := range extras {
+		result := newResult(extra)
+
+		rpt.Add(result)
+
+		if failed[extra.Monitor.ID] {
+			result.Action = report.ActionFailed
+			result.Error = fmt.Errorf("duplicate of %s was not removed because its alert conditions could not be migrated", survivor.Monitor.ID)
+			continue
+		}
+
+		if entityutils.Protected(extra.Entity.Tags) {
+			result.Action = report.ActionSkipped
+			result.Reason = fmt.Sprintf("duplicate of %s is protected by the %s tag", survivor.Monitor.ID, entityutils.TagDoNotDelete)
+			continue
+		}
+
+		tags := remaining(extra.Entity.Tags, migrated[extra.Monitor.ID])
+
+		switch opts.Action {
+		case ActionDisable:
+			disable(client, result, extra, survivor, tags, opts.DryRun)
+		default:
+			inventory.Cascade(client, rpt, result, tags, opts.DryRun)
+		}
+
+		result.Reason = fmt.Sprintf("duplicate of %s", survivor.Monitor.ID)
+	}
+}
+
+// Helper function to point an alert condition at the survivor.
+func migrate(client *api.Client, policyID, conditionID int, survivor *synthetics.Monitor) error {
+	var condition *alerts.SyntheticsCondition
+
+	err := client.Call("Alerts.GetSyntheticsCondition", func() error {
+		var err error
+		condition, err = client.Alerts.GetSyntheticsCondition(policyID, conditionID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	condition.MonitorID = survivor.ID
+	condition.Name = survivor.Name
+
+	return client.Call("Alerts.UpdateSyntheticsCondition", func() error {
+		_, err := client.Alerts.UpdateSyntheticsCondition(*condition)
+		return err
+	})
+}
+
+// Helper function to record the migrated inventory and copy unmanaged tags onto the survivor.
+func retag(client *api.Client, survivor Member, items []inventory.Item, unmanaged []entities.Tag) error {
+	var desired []entities.Tag
+
+	for _, tag := range survivor.Entity.Tags {
+		if tag == nil || tag.Key == entityutils.TagInventory || !contains(entityutils.ManagedTags, tag.Key) {
+			continue
+		}
+
+		desired = append(desired, *tag)
+	}
+
+	inventory.Sort(items)
+
+	_, err := entityutils.ReconcileTags(client, survivor.Entity.GUID, survivor.Entity.Tags, append(desired, inventory.Tags(items)...))
+	if err != nil {
+		return err
+	}
+
+	if len(unmanaged) == 0 {
+		return nil
+	}
+
+	return client.Call("NerdGraph.taggingAddTagsToEntity", func() error {
+		return client.Entities.AddTags(survivor.Entity.GUID, unmanaged)
+	})
+}
+
+// Helper function to disable an extra and rename it, so it is no longer matched to its Route by name.
+// The tags which mark it as managed are removed, other than the objects which are still in its inventory.
+func disable(client *api.Client, result *report.Result, extra, survivor Member, tags []*entities.Tag, dryRun bool) {
+	if dryRun {
+		result.Action = report.ActionDryRun
+		return
+	}
+
+	monitor := *extra.Monitor
+	monitor.Name = fmt.Sprintf("%s (duplicate of %s)", extra.Monitor.Name, survivor.Monitor.ID)
+	monitor.Status = synthetics.MonitorStatus.Disabled
+
+	err := client.Call("Synthetics.UpdateMonitor", func() error {
+		_, err := client.Synthetics.UpdateMonitor(monitor)
+		return err
+	})
+	if err != nil {
+		result.Action = report.ActionFailed
+		result.Error = fmt.Errorf("failed to disable duplicate: %w", err)
+		return
+	}
+
+	_, err = entityutils.ReconcileTags(client, extra.Entity.GUID, extra.Entity.Tags, inventory.Tags(inventory.FromTags(tags)))
+	if err != nil {
+		result.Action = report.ActionFailed
+		result.Error = fmt.Errorf("failed to untag duplicate: %w", err)
+		return
+	}
+
+	result.Action = report.ActionDisabled
+}
+
+// Helper function to remove the migrated items from the inventory of an extra.
+func remaining(tags []*entities.Tag, migrated map[inventory.Item]bool) []*entities.Tag {
+	var list []*entities.Tag
+
+	for _, tag := range tags {
+		if tag == nil {
+			continue
+		}
+
+		if tag.Key != entityutils.TagInventory {
+			list = append(list, tag)
+			continue
+		}
+
+		var items []inventory.Item
+
+		for _, item := range inventory.FromTags([]*entities.Tag{tag}) {
+			if !migrated[item] {
+				items = append(items, item)
+			}
+		}
+
+		for _, t := range inventory.Tags(items) {
+			t := t
+			list = append(list, &t)
+		}
+	}
+
+	return list
+}
+
+// Helper function to build the result for a member of a group.
+func newResult(member Member) *report.Result {
+	result := &report.Result{
+		URL:       member.Monitor.URI,
+		Monitor:   member.Monitor.Name,
+		MonitorID: member.Monitor.ID,
+		GUID:      member.Entity.GUID,
+		Permalink: member.Entity.Permalink,
+	}
+
+	if namespace, ok := entityutils.TagValue(member.Entity.Tags, entityutils.TagOpenShiftRouteNamespace); ok {
+		result.Namespace = namespace
+		result.Route, _ = entityutils.TagValue(member.Entity.Tags, entityutils.TagOpenShiftRouteName)
+	} else if namespace, ok := entityutils.TagValue(member.Entity.Tags, entityutils.TagSyntheticMonitorNamespace); ok {
+		result.Namespace = namespace
+		result.Route, _ = entityutils.TagValue(member.Entity.Tags, entityutils.TagSyntheticMonitorName)
+	}
+
+	return result
+}
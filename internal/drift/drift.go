@@ -0,0 +1,223 @@
+// Package drift detects monitors which were edited outside of this tool eg. in the New Relic UI.
+package drift
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	"gopkg.in/alecthomas/kingpin.v2"
+
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+)
+
+// Policy applied to monitors which have drifted from their desired state.
+type Policy string
+
+const (
+	// PolicyReport leaves drifted monitors as they are and reports the differences.
+	PolicyReport Policy = "report"
+	// PolicyRevert updates drifted monitors back to their desired state.
+	PolicyRevert Policy = "revert"
+	// PolicyAccept keeps the edits and records them, so they are kept until the desired state changes.
+	PolicyAccept Policy = "accept"
+)
+
+// Policies which can be applied.
+var Policies = []string{
+	string(PolicyReport),
+	string(PolicyRevert),
+	string(PolicyAccept),
+}
+
+// Options for handling drift.
+type Options struct {
+	Policy string
+}
+
+// Flags which configure how drift is handled.
+func (o *Options) Flags(command *kingpin.CmdClause, policy Policy) {
+	command.Flag("drift-policy", "How monitors which were edited outside of this tool are handled").Envar("DRIFT_POLICY").Default(string(policy)).EnumVar(&o.Policy, Policies...)
+}
+
+// A field which is compared between the desired and live monitor.
+type field struct {
+	name string
+	get  func(synthetics.Monitor) string
+	set  func(dst *synthetics.Monitor, src synthetics.Monitor)
+}
+
+// Fields which are compared, the name is the identity of a monitor so it is never compared.
+var fields = []field{
+	{
+		name: monitorutils.FieldType,
+		get:  func(m synthetics.Monitor) string { return string(m.Type) },
+		set:  func(dst *synthetics.Monitor, src synthetics.Monitor) { dst.Type = src.Type },
+	},
+	{
+		name: monitorutils.FieldFrequency,
+		get:  func(m synthetics.Monitor) string { return strconv.FormatUint(uint64(m.Frequency), 10) },
+		set:  func(dst *synthetics.Monitor, src synthetics.Monitor) { dst.Frequency = src.Frequency },
+	},
+	{
+		name: monitorutils.FieldURI,
+		get:  func(m synthetics.Monitor) string { return m.URI },
+		set:  func(dst *synthetics.Monitor, src synthetics.Monitor) { dst.URI = src.URI },
+	},
+	{
+		name: monitorutils.FieldLocations,
+		get: func(m synthetics.Monitor) string {
+			locations := append([]string(nil), m.Locations...)
+			sort.Strings(locations)
+			return strings.Join(locations, ",")
+		},
+		set: func(dst *synthetics.Monitor, src synthetics.Monitor) { dst.Locations = src.Locations },
+	},
+	{
+		name: monitorutils.FieldStatus,
+		get:  func(m synthetics.Monitor) string { return string(m.Status) },
+		set:  func(dst *synthetics.Monitor, src synthetics.Monitor) { dst.Status = src.Status },
+	},
+	{
+		name: monitorutils.FieldSLAThreshold,
+		get:  func(m synthetics.Monitor) string { return strconv.FormatFloat(m.SLAThreshold, 'f', -1, 64) },
+		set:  func(dst *synthetics.Monitor, src synthetics.Monitor) { dst.SLAThreshold = src.SLAThreshold },
+	},
+}
+
+// Compare the desired and live state of a monitor, returning a difference for each field which doesn't match.
+func Compare(desired, live synthetics.Monitor) []report.Difference {
+	var differences []report.Difference
+
+	for _, f := range fields {
+		if want, have := f.get(desired), f.get(live); want != have {
+			differences = append(differences, report.Difference{
+				Field:   f.name,
+				Desired: want,
+				Live:    have,
+			})
+		}
+	}
+
+	return differences
+}
+
+// Accepted edits which are recorded on a monitor, keyed by field with the desired value at the time they were accepted.
+func Accepted(tags []*entities.Tag) map[string]string {
+	accepted := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag == nil || tag.Key != entityutils.TagDriftAccepted {
+			continue
+		}
+
+		for _, value := range tag.Values {
+			sep := strings.Index(value, "=")
+			if sep < 1 {
+				continue
+			}
+
+			accepted[value[:sep]] = value[sep+1:]
+		}
+	}
+
+	return accepted
+}
+
+// Resolve the drift of a monitor according to a policy, updating the desired monitor with the live value of each
+// accepted field. Edits which were accepted previously are kept for as long as the desired value is unchanged.
+// Returns the differences which are drift, and every accepted difference which should be recorded.
+func Resolve(desired *synthetics.Monitor, live synthetics.Monitor, accepted map[string]string, policy Policy) ([]report.Difference, []report.Difference) {
+	var drifted, keep []report.Difference
+
+	for _, f := range fields {
+		want, have := f.get(*desired), f.get(live)
+		if want == have {
+			continue
+		}
+
+		difference := report.Difference{
+			Field:   f.name,
+			Desired: want,
+			Live:    have,
+		}
+
+		if value, ok := accepted[f.name]; !ok || value != want {
+			drifted = append(drifted, difference)
+
+			if policy != PolicyAccept {
+				continue
+			}
+		}
+
+		f.set(desired, live)
+		keep = append(keep, difference)
+	}
+
+	return drifted, keep
+}
+
+// Tags which record the accepted differences.
+func Tags(accepted []report.Difference) []entities.Tag {
+	if len(accepted) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(accepted))
+
+	for i, difference := range accepted {
+		values[i] = fmt.Sprintf("%s=%s", difference.Field, difference.Desired)
+	}
+
+	return []entities.Tag{
+		{
+			Key:    entityutils.TagDriftAccepted,
+			Values: values,
+		},
+	}
+}
+
+// Summary of differences for logs and reports eg. frequency (live 5, desired 10).
+func Summary(differences []report.Difference) string {
+	list := make([]string, len(differences))
+
+	for i, difference := range differences {
+		list[i] = fmt.Sprintf("%s (live %s, desired %s)", difference.Field, difference.Live, difference.Desired)
+	}
+
+	return strings.Join(list, ", ")
+}
+
+// Print a table of the differences in each result, nothing is printed when there are no differences.
+func Print(w io.Writer, results []*report.Result) error {
+	var found bool
+
+	for _, result := range results {
+		found = found || len(result.Drift) > 0
+	}
+
+	if !found {
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "NAMESPACE\tROUTE\tFIELD\tLIVE\tDESIRED")
+
+	for _, result := range results {
+		for _, difference := range result.Drift {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", result.Namespace, result.Route, difference.Field, difference.Live, difference.Desired)
+		}
+	}
+
+	fmt.Fprintln(tw)
+
+	return tw.Flush()
+}
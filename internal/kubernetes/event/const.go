@@ -0,0 +1,17 @@
+package event
+
+const (
+	// Component which is recorded as the source of Events.
+	Component = "openshift-newrelic-synthetics"
+
+	// ReasonMonitorCreated is used when a monitor was created for a Route.
+	ReasonMonitorCreated = "MonitorCreated"
+	// ReasonMonitorUpdated is used when a monitor was updated for a Route.
+	ReasonMonitorUpdated = "MonitorUpdated"
+	// ReasonMonitorSkipped is used when a Route was skipped.
+	ReasonMonitorSkipped = "MonitorSkipped"
+	// ReasonMonitorDrifted is used when a monitor was edited outside of this tool and was left as it is.
+	ReasonMonitorDrifted = "MonitorDrifted"
+	// ReasonMonitorFailed is used when a monitor could not be synced for a Route.
+	ReasonMonitorFailed = "MonitorFailed"
+)
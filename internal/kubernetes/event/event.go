@@ -0,0 +1,59 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// Record an Event for an object.
+// Events with the same reason are aggregated into a single Event with a count, the same way the kubelet does,
+// so that frequent runs do not flood the namespace.
+func Record(client clientset.EventsGetter, ref corev1.ObjectReference, eventType, reason, message string) error {
+	now := metav1.Now()
+
+	name := fmt.Sprintf("%s.%s", ref.Name, strings.ToLower(reason))
+
+	events := client.Events(ref.Namespace)
+
+	existing, err := events.Get(context.Background(), name, metav1.GetOptions{})
+	if err == nil {
+		existing.InvolvedObject = ref
+		existing.Type = eventType
+		existing.Message = message
+		existing.Count++
+		existing.LastTimestamp = now
+
+		_, err = events.Update(context.Background(), existing, metav1.UpdateOptions{})
+
+		return err
+	}
+
+	if !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	_, err = events.Create(context.Background(), &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ref.Namespace,
+		},
+		InvolvedObject: ref,
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source: corev1.EventSource{
+			Component: Component,
+		},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}, metav1.CreateOptions{})
+
+	return err
+}
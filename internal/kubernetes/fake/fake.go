@@ -0,0 +1,61 @@
+// Package fake provides in-memory Kubernetes and OpenShift clients for exercising commands end-to-end.
+package fake
+
+import (
+	routev1 "github.com/openshift/api/route/v1"
+	routefake "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1/fake"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	corefake "k8s.io/client-go/kubernetes/typed/core/v1/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// Clientset which shares a single object tracker between the Route and core clients.
+type Clientset struct {
+	k8stesting.Fake
+
+	// Route client which implements the OpenShift RoutesGetter.
+	Route *routefake.FakeRouteV1
+	// Core client which implements the Kubernetes core/v1 getters eg. Events, Namespaces and Secrets.
+	Core *corefake.FakeCoreV1
+
+	tracker k8stesting.ObjectTracker
+}
+
+// New clientset seeded with objects.
+func New(objects ...runtime.Object) *Clientset {
+	scheme := runtime.NewScheme()
+
+	if err := corev1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+
+	if err := routev1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+
+	tracker := k8stesting.NewObjectTracker(scheme, serializer.NewCodecFactory(scheme).UniversalDecoder())
+
+	for _, obj := range objects {
+		if err := tracker.Add(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	cs := &Clientset{
+		tracker: tracker,
+	}
+
+	cs.AddReactor("*", "*", k8stesting.ObjectReaction(tracker))
+
+	cs.Route = &routefake.FakeRouteV1{Fake: &cs.Fake}
+	cs.Core = &corefake.FakeCoreV1{Fake: &cs.Fake}
+
+	return cs
+}
+
+// Tracker which stores the objects, for adding or removing objects between runs.
+func (c *Clientset) Tracker() k8stesting.ObjectTracker {
+	return c.tracker
+}
@@ -0,0 +1,171 @@
+package leader
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/alecthomas/kingpin.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coordinationclient "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// Election of a single replica which is allowed to reconcile.
+type Election struct {
+	Enabled       bool
+	Namespace     string
+	Name          string
+	Identity      string
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+	HealthAddr    string
+
+	leader int32
+}
+
+// Flags which configure leader election for a command.
+func (e *Election) Flags(command *kingpin.CmdClause) {
+	hostname, _ := os.Hostname()
+
+	command.Flag("leader-elect", "Use a Lease so only one replica reconciles at a time").Envar("LEADER_ELECT").BoolVar(&e.Enabled)
+	command.Flag("leader-elect-namespace", "Namespace of the Lease used for leader election").Envar("LEADER_ELECT_NAMESPACE").Default("default").StringVar(&e.Namespace)
+	command.Flag("leader-elect-name", "Name of the Lease used for leader election").Envar("LEADER_ELECT_NAME").Default("openshift-newrelic-synthetics-" + command.FullCommand()).StringVar(&e.Name)
+	command.Flag("leader-elect-identity", "Identity of this replica in the Lease").Envar("LEADER_ELECT_IDENTITY").Default(hostname).StringVar(&e.Identity)
+	command.Flag("leader-elect-lease-duration", "Duration which standby replicas wait before taking over an unrenewed Lease").Envar("LEADER_ELECT_LEASE_DURATION").Default("15s").DurationVar(&e.LeaseDuration)
+	command.Flag("leader-elect-renew-deadline", "Duration which the leader retries renewing the Lease before giving up").Envar("LEADER_ELECT_RENEW_DEADLINE").Default("10s").DurationVar(&e.RenewDeadline)
+	command.Flag("leader-elect-retry-period", "Duration between attempts to acquire or renew the Lease").Envar("LEADER_ELECT_RETRY_PERIOD").Default("2s").DurationVar(&e.RetryPeriod)
+	command.Flag("health-addr", "Address to serve the health endpoint on eg. :8080").Envar("HEALTH_ADDR").StringVar(&e.HealthAddr)
+}
+
+// IsLeader returns true if this replica currently holds the Lease.
+// Replicas which are not using leader election are always the leader.
+func (e *Election) IsLeader() bool {
+	return !e.Enabled || atomic.LoadInt32(&e.leader) == 1
+}
+
+// Run a function once this replica has been elected, or straight away if leader election is disabled.
+// The process exits if leadership is lost so that a replica never reconciles without holding the Lease.
+// The Lease is released once the function returns.
+func (e *Election) Run(config *rest.Config, fn func() error) error {
+	if !e.Enabled {
+		e.serve(nil)
+		return fn()
+	}
+
+	client, err := coordinationclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Namespace: e.Namespace,
+			Name:      e.Name,
+		},
+		Client: client,
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: e.Identity,
+		},
+	}
+
+	watchdog := leaderelection.NewLeaderHealthzAdaptor(e.RenewDeadline)
+
+	e.serve(watchdog)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var result error
+
+	logger := log.WithFields(log.Fields{
+		"lease":    fmt.Sprintf("%s/%s", e.Namespace, e.Name),
+		"identity": e.Identity,
+	})
+
+	logger.Infoln("Waiting for leadership")
+
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   e.LeaseDuration,
+		RenewDeadline:   e.RenewDeadline,
+		RetryPeriod:     e.RetryPeriod,
+		ReleaseOnCancel: true,
+		WatchDog:        watchdog,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				atomic.StoreInt32(&e.leader, 1)
+				logger.Infoln("Started leading")
+				result = fn()
+				// Release the Lease so a standby replica can take over straight away.
+				cancel()
+			},
+			OnStoppedLeading: func() {
+				atomic.StoreInt32(&e.leader, 0)
+
+				if ctx.Err() == nil {
+					logger.Fatalln("Lost leadership")
+				}
+
+				logger.Infoln("Released leadership")
+			},
+			OnNewLeader: func(identity string) {
+				if identity != e.Identity {
+					logger.Infoln("New leader elected:", identity)
+				}
+			},
+		},
+	})
+
+	return result
+}
+
+// Helper function to serve the health endpoint, which reports whether this replica is the leader.
+func (e *Election) serve(watchdog *leaderelection.HealthzAdaptor) {
+	if e.HealthAddr == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		status := http.StatusOK
+
+		health := map[string]interface{}{
+			"leader":   e.IsLeader(),
+			"identity": e.Identity,
+		}
+
+		if watchdog != nil {
+			if err := watchdog.Check(r); err != nil {
+				status = http.StatusInternalServerError
+				health["error"] = err.Error()
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+
+		err := json.NewEncoder(w).Encode(health)
+		if err != nil {
+			log.Errorln("Failed to encode health:", err)
+		}
+	})
+
+	go func() {
+		log.Infoln("Serving health on:", e.HealthAddr)
+
+		err := http.ListenAndServe(e.HealthAddr, mux)
+		if err != nil {
+			log.Fatalln("Failed to serve health:", err)
+		}
+	}()
+}
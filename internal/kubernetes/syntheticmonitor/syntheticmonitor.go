@@ -0,0 +1,43 @@
+package syntheticmonitor
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+)
+
+// List the SyntheticMonitors in a namespace.
+func List(client dynamic.Interface, namespace string) ([]v1alpha1.SyntheticMonitor, error) {
+	list, err := client.Resource(v1alpha1.SyntheticMonitorResource).Namespace(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	monitors := make([]v1alpha1.SyntheticMonitor, len(list.Items))
+
+	for i, item := range list.Items {
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &monitors[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return monitors, nil
+}
+
+// UpdateStatus of a SyntheticMonitor.
+func UpdateStatus(client dynamic.Interface, monitor v1alpha1.SyntheticMonitor) error {
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&monitor)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Resource(v1alpha1.SyntheticMonitorResource).Namespace(monitor.ObjectMeta.Namespace).UpdateStatus(context.Background(), &unstructured.Unstructured{Object: object}, metav1.UpdateOptions{})
+
+	return err
+}
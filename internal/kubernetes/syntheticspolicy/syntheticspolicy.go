@@ -0,0 +1,43 @@
+package syntheticspolicy
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+)
+
+// List every SyntheticsPolicy in the cluster.
+// An empty list is returned if the custom resource definition has not been installed, or if policies can't be
+// listed because the deployment only has a namespaced Role, so Routes still get the defaults and their annotations.
+func List(client dynamic.Interface) ([]v1alpha1.SyntheticsPolicy, error) {
+	list, err := client.Resource(v1alpha1.SyntheticsPolicyResource).List(context.Background(), metav1.ListOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if kerrors.IsForbidden(err) {
+		log.Warnln("Ignoring SyntheticsPolicies because they could not be listed, grant list on syntheticspolicies to use them:", err)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]v1alpha1.SyntheticsPolicy, len(list.Items))
+
+	for i, item := range list.Items {
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &policies[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return policies, nil
+}
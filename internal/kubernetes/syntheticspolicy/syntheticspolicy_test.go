@@ -0,0 +1,85 @@
+package syntheticspolicy
+
+import (
+	"context"
+	"testing"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+)
+
+// Dynamic client which responds to every list with the same result.
+type listClient struct {
+	dynamic.Interface
+	list *unstructured.UnstructuredList
+	err  error
+}
+
+func (c listClient) Resource(schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return listResource{list: c.list, err: c.err}
+}
+
+type listResource struct {
+	dynamic.NamespaceableResourceInterface
+	list *unstructured.UnstructuredList
+	err  error
+}
+
+func (r listResource) List(context.Context, metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return r.list, r.err
+}
+
+func TestList(t *testing.T) {
+	resource := v1alpha1.SyntheticsPolicyResource.GroupResource()
+
+	list := &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			{
+				Object: map[string]interface{}{
+					"metadata": map[string]interface{}{"name": "default"},
+					"spec": map[string]interface{}{
+						"priority": int64(10),
+						"defaults": map[string]interface{}{"frequency": int64(5)},
+					},
+				},
+			},
+		},
+	}
+
+	policies, err := List(listClient{list: list})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(policies) != 1 || policies[0].ObjectMeta.Name != "default" || policies[0].Spec.Defaults.Frequency != 5 {
+		t.Errorf("expected the policy to be decoded, got %+v", policies)
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		fail bool
+	}{
+		{name: "not installed", err: kerrors.NewNotFound(resource, "")},
+		{name: "forbidden", err: kerrors.NewForbidden(resource, "", nil)},
+		{name: "unavailable", err: kerrors.NewServiceUnavailable("unavailable"), fail: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policies, err := List(listClient{err: test.err})
+			if (err != nil) != test.fail {
+				t.Errorf("expected failure %v, got %v", test.fail, err)
+			}
+
+			if len(policies) != 0 {
+				t.Errorf("expected no policies, got %+v", policies)
+			}
+		})
+	}
+}
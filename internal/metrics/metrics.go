@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+)
+
+// Namespace used for all metrics exposed by this tool.
+const Namespace = "openshift_newrelic_synthetics"
+
+var (
+	// RoutesDiscovered is the number of Routes found during the last run.
+	RoutesDiscovered = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "routes_discovered",
+		Help:      "Number of OpenShift Routes discovered during the last run.",
+	}, []string{"namespace"})
+
+	// Monitors is the number of monitors processed, partitioned by the action taken.
+	Monitors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "monitors_total",
+		Help:      "Number of monitors processed, partitioned by the action taken.",
+	}, []string{"command", "action"})
+
+	// APICalls is the number of New Relic API calls, partitioned by endpoint and status.
+	APICalls = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "newrelic_api_calls_total",
+		Help:      "Number of New Relic API calls, partitioned by endpoint and status.",
+	}, []string{"endpoint", "status"})
+
+	// APICallDuration is the latency of New Relic API calls.
+	APICallDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Name:      "newrelic_api_call_duration_seconds",
+		Help:      "Latency of New Relic API calls.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"endpoint"})
+
+	// LastSuccess is the time of the last run which completed without errors.
+	LastSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Unix timestamp of the last run which completed without errors.",
+	}, []string{"command"})
+
+	// Drift is the number of monitors which had drifted from their desired state during the last run.
+	Drift = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "drift_monitors",
+		Help:      "Number of monitors which had drifted from their desired state during the last run.",
+	}, []string{"kind"})
+
+	// Duplicates is the number of groups of monitors which duplicate each other, found during the last run.
+	Duplicates = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "duplicate_monitors",
+		Help:      "Number of groups of monitors which duplicate each other, found during the last run.",
+	}, []string{"namespace"})
+
+	// MonitorListings is the number of times monitors were listed, partitioned by whether every monitor was listed
+	// or only the changes since the cached listing.
+	MonitorListings = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "monitor_listings_total",
+		Help:      "Number of times monitors were listed, partitioned by whether the listing was full or incremental.",
+	}, []string{"kind"})
+
+	// Untagged is the number of monitors which are waiting for their tags because their entities have not been indexed.
+	Untagged = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "untagged_monitors",
+		Help:      "Number of monitors which are waiting for their tags because their entities have not been indexed.",
+	}, []string{"namespace"})
+)
+
+// ObserveAPICall records a single New Relic API call.
+func ObserveAPICall(endpoint, status string, duration time.Duration) {
+	APICalls.WithLabelValues(endpoint, status).Inc()
+	APICallDuration.WithLabelValues(endpoint).Observe(duration.Seconds())
+}
+
+// Success records that a command completed without errors.
+func Success(command string) {
+	LastSuccess.WithLabelValues(command).SetToCurrentTime()
+}
+
+// Serve metrics in the background if an address has been provided.
+func Serve(addr string) {
+	if addr == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	go func() {
+		log.Infoln("Serving metrics on:", addr)
+
+		err := http.ListenAndServe(addr, mux)
+		if err != nil {
+			log.Fatalln("Failed to serve metrics:", err)
+		}
+	}()
+}
+
+// Report records the action taken for every result in a report.
+func Report(command string, rpt *report.Report) {
+	for _, result := range rpt.Results() {
+		Monitors.WithLabelValues(command, string(result.Action)).Inc()
+	}
+}
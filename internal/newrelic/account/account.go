@@ -0,0 +1,176 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/newrelic"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/alecthomas/kingpin.v2"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+)
+
+// Account which monitors are managed in.
+type Account struct {
+	ID     int
+	Region string
+	APIKey string
+	// APIKeyFile which the API key is read from on every run, so it can be rotated without a restart.
+	APIKeyFile string
+	// APIKeySecret which the API key is read from on every run, in the form "namespace/name" or "namespace/name/key".
+	APIKeySecret string
+	// Fallback to this account when a namespace can't be read, instead of failing.
+	Fallback bool
+}
+
+// String describes the account without exposing the API key.
+func (a Account) String() string {
+	return fmt.Sprintf("account %d (%s)", a.ID, a.Region)
+}
+
+// HasAPIKey returns true if an API key has been provided by any source.
+func (a Account) HasAPIKey() bool {
+	return a.APIKey != "" || a.APIKeyFile != "" || a.APIKeySecret != ""
+}
+
+// Flags which configure the default account for a command.
+func (a *Account) Flags(command *kingpin.CmdClause) {
+	command.Flag("new-relic-api-key", "API key for authenticating with New Relic").Envar("NEW_RELIC_API_KEY").StringVar(&a.APIKey)
+	command.Flag("new-relic-api-key-file", "File which the API key is read from on every run eg. a mounted Secret").Envar("NEW_RELIC_API_KEY_FILE").StringVar(&a.APIKeyFile)
+	command.Flag("new-relic-api-key-secret", "Secret which the API key is read from on every run eg. namespace/name or namespace/name/key").Envar("NEW_RELIC_API_KEY_SECRET").StringVar(&a.APIKeySecret)
+	command.Flag("new-relic-region", "Region of the New Relic account eg. US or EU").Envar("NEW_RELIC_REGION").Default(DefaultRegion).EnumVar(&a.Region, "US", "EU", "us", "eu")
+	command.Flag("new-relic-account-id", "ID of the New Relic account which monitors are managed in").Envar("NEW_RELIC_ACCOUNT_ID").IntVar(&a.ID)
+	command.Flag("new-relic-account-fallback", "Use the default account when the namespace can't be read, for deployments which only have a namespaced Role").Envar("NEW_RELIC_ACCOUNT_FALLBACK").BoolVar(&a.Fallback)
+}
+
+// Resolve the account for a namespace. Annotations on the namespace override the defaults, so that
+// namespaces can be routed to their own account without running a separate deployment.
+func Resolve(client coreclient.CoreV1Interface, namespace string, defaults Account) (Account, error) {
+	a := defaults
+
+	ns, err := client.Namespaces().Get(context.Background(), namespace, metav1.GetOptions{})
+	if kerrors.IsForbidden(err) && a.Fallback {
+		// Deployments which were installed before namespace routing only have a namespaced Role.
+		log.Warnln("Using the default New Relic account because the namespace could not be read:", err)
+		return a.LoadAPIKey(client)
+	}
+
+	if kerrors.IsForbidden(err) {
+		// Falling back would manage the namespace's monitors in the wrong account if it has been routed to its own.
+		return a, fmt.Errorf("failed to get namespace, grant get on namespaces or set --new-relic-account-fallback: %w", err)
+	}
+
+	if err != nil {
+		return a, fmt.Errorf("failed to get namespace: %w", err)
+	}
+
+	annotations := ns.ObjectMeta.Annotations
+
+	if value, ok := annotations[AnnotationAccountID]; ok {
+		a.ID, err = strconv.Atoi(value)
+		if err != nil {
+			return a, fmt.Errorf("%s must be a number: %q", AnnotationAccountID, value)
+		}
+	}
+
+	if value, ok := annotations[AnnotationRegion]; ok {
+		if !strings.EqualFold(value, "US") && !strings.EqualFold(value, "EU") {
+			return a, fmt.Errorf("%s must be US or EU: %q", AnnotationRegion, value)
+		}
+
+		a.Region = value
+	}
+
+	if value, ok := annotations[AnnotationAPIKeySecret]; ok {
+		a.APIKey, err = secretValue(client, namespace, value)
+		return a, err
+	}
+
+	return a.LoadAPIKey(client)
+}
+
+// LoadAPIKey reads the API key from its Secret or file, falling back to the API key which was provided directly.
+// The client is only required when the API key is read from a Secret.
+func (a Account) LoadAPIKey(client coreclient.SecretsGetter) (Account, error) {
+	switch {
+	case a.APIKeySecret != "":
+		if client == nil {
+			return a, fmt.Errorf("reading the API key from a secret is not supported by this command")
+		}
+
+		parts := strings.SplitN(a.APIKeySecret, "/", 2)
+		if len(parts) != 2 {
+			return a, fmt.Errorf("API key secret must be in the form namespace/name or namespace/name/key")
+		}
+
+		key, err := secretValue(client, parts[0], parts[1])
+		if err != nil {
+			return a, err
+		}
+
+		a.APIKey = key
+	case a.APIKeyFile != "":
+		data, err := ioutil.ReadFile(a.APIKeyFile)
+		if err != nil {
+			return a, fmt.Errorf("failed to read API key file: %w", err)
+		}
+
+		a.APIKey = strings.TrimSpace(string(data))
+	}
+
+	return a, nil
+}
+
+// Client for managing monitors in the account.
+func (a Account) Client(limits api.Limits) (*api.Client, error) {
+	if a.APIKey == "" {
+		return nil, fmt.Errorf("an API key is required, see --new-relic-api-key, --new-relic-api-key-file, --new-relic-api-key-secret or the %s annotation", AnnotationAPIKeySecret)
+	}
+
+	region := a.Region
+	if region == "" {
+		region = DefaultRegion
+	}
+
+	client, err := api.New(limits, newrelic.ConfigPersonalAPIKey(a.APIKey), newrelic.ConfigRegion(strings.ToUpper(region)))
+	if err != nil {
+		return nil, err
+	}
+
+	client.AccountID = a.ID
+
+	log.WithFields(log.Fields{
+		"account": a.ID,
+		"region":  strings.ToUpper(region),
+	}).Infoln("Using New Relic account")
+
+	return client, nil
+}
+
+// Helper function to read an API key from a Secret reference in the form "name" or "name/key".
+func secretValue(client coreclient.SecretsGetter, namespace, reference string) (string, error) {
+	name, key := reference, DefaultSecretKey
+
+	if i := strings.Index(reference, "/"); i >= 0 {
+		name, key = reference[:i], reference[i+1:]
+	}
+
+	secret, err := client.Secrets(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get secret %s: %w", name, err)
+	}
+
+	value, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s", key, name)
+	}
+
+	return strings.TrimSpace(string(value)), nil
+}
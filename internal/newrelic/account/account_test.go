@@ -0,0 +1,73 @@
+package account
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+
+	kubefake "github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/fake"
+)
+
+func TestResolve(t *testing.T) {
+	kube := kubefake.New(
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "customer-a",
+				Annotations: map[string]string{
+					AnnotationAccountID:    "1234567",
+					AnnotationRegion:       "EU",
+					AnnotationAPIKeySecret: "newrelic",
+				},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "customer-a",
+				Name:      "newrelic",
+			},
+			Data: map[string][]byte{
+				DefaultSecretKey: []byte("secret\n"),
+			},
+		},
+	)
+
+	a, err := Resolve(kube.Core, "customer-a", Account{ID: 1, Region: "US", APIKey: "default"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.ID != 1234567 || a.Region != "EU" || a.APIKey != "secret" {
+		t.Errorf("expected the account from the namespace, got %s", a)
+	}
+}
+
+func TestResolveForbidden(t *testing.T) {
+	kube := kubefake.New()
+
+	kube.PrependReactor("get", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, kerrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "customer-a", nil)
+	})
+
+	defaults := Account{ID: 1, Region: "US", APIKey: "default"}
+
+	_, err := Resolve(kube.Core, "customer-a", defaults)
+	if err == nil {
+		t.Fatal("expected an error when the namespace can't be read")
+	}
+
+	defaults.Fallback = true
+
+	a, err := Resolve(kube.Core, "customer-a", defaults)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.ID != 1 || a.APIKey != "default" {
+		t.Errorf("expected the default account, got %s", a)
+	}
+}
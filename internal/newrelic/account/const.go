@@ -0,0 +1,16 @@
+package account
+
+const (
+	// AnnotationAccountID overrides the New Relic account which a namespace's monitors are managed in.
+	AnnotationAccountID = "synthetics.codedrop.com.au/account-id"
+	// AnnotationRegion overrides the New Relic region of a namespace's account.
+	AnnotationRegion = "synthetics.codedrop.com.au/region"
+	// AnnotationAPIKeySecret references a Secret in the namespace which holds the API key for its account.
+	// The value is the name of the Secret, optionally followed by the key eg. "newrelic/api-key".
+	AnnotationAPIKeySecret = "synthetics.codedrop.com.au/api-key-secret"
+
+	// DefaultSecretKey is used when a Secret reference does not include a key.
+	DefaultSecretKey = "api-key"
+	// DefaultRegion is used when a region has not been set.
+	DefaultRegion = "US"
+)
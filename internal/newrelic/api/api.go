@@ -0,0 +1,219 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/newrelic"
+	nrerrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/metrics"
+)
+
+// Limits applied to every New Relic API call made by a client.
+type Limits struct {
+	// Rate of API calls per second shared by all callers.
+	Rate float64
+	// Burst of API calls which may be made above the rate.
+	Burst int
+	// Retries for API calls which were rate limited, timed out or found New Relic unavailable.
+	Retries int
+	// Backoff before the first retry, doubled for each retry after that.
+	Backoff time.Duration
+}
+
+// Client for interacting with New Relic which keeps track of the API calls made.
+type Client struct {
+	*newrelic.NewRelic
+
+	// AccountID which monitors are managed in, if known.
+	AccountID int
+
+	limits  Limits
+	limiter *rate.Limiter
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+// The maximum amount of time to wait between retries.
+const maxBackoff = time.Minute
+
+// Used to extract the status code from errors returned by the New Relic client.
+var statusCodeRegex = regexp.MustCompile(`^(\d{3}) response returned`)
+
+// New client for interacting with New Relic.
+func New(limits Limits, opts ...newrelic.ConfigOption) (*Client, error) {
+	client, err := newrelic.New(append(opts, retryOptions()...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	limiter := rate.NewLimiter(rate.Inf, 0)
+
+	if limits.Rate > 0 {
+		if limits.Burst < 1 {
+			limits.Burst = 1
+		}
+
+		limiter = rate.NewLimiter(rate.Limit(limits.Rate), limits.Burst)
+	}
+
+	return &Client{
+		NewRelic: client,
+		limits:   limits,
+		limiter:  limiter,
+		calls:    make(map[string]int),
+	}, nil
+}
+
+// Call executes a single New Relic API call and records it against an endpoint.
+// Calls are rate limited and retried if New Relic asks us to slow down, is unavailable or the call times out.
+func (c *Client) Call(endpoint string, fn func() error) error {
+	return c.call(endpoint, c.limits.Retries, fn)
+}
+
+// Create executes a New Relic API call which isn't safe to retry, because a retry could create a duplicate.
+// Callers should check whether the change was made when the error is Ambiguous.
+func (c *Client) Create(endpoint string, fn func() error) error {
+	return c.call(endpoint, 0, fn)
+}
+
+// Helper function to execute a rate limited API call with a number of retries.
+func (c *Client) call(endpoint string, retries int, fn func() error) error {
+	backoff := c.limits.Backoff
+
+	for attempt := 0; ; attempt++ {
+		err := c.limiter.Wait(context.Background())
+		if err != nil {
+			return err
+		}
+
+		c.mu.Lock()
+		c.calls[endpoint]++
+		c.mu.Unlock()
+
+		start := time.Now()
+
+		err = fn()
+
+		metrics.ObserveAPICall(endpoint, status(err), time.Since(start))
+		if err == nil || attempt >= retries || !Retryable(err) {
+			return err
+		}
+
+		wait := backoff + time.Duration(rand.Int63n(int64(backoff/2)+1))
+
+		if after, ok := RetryAfter(err); ok {
+			wait = after
+		}
+
+		if wait > maxBackoff {
+			wait = maxBackoff
+		}
+
+		log.WithFields(log.Fields{
+			"endpoint": endpoint,
+			"attempt":  attempt + 1,
+			"wait":     wait,
+		}).Warnln("Retrying New Relic API call:", err)
+
+		time.Sleep(wait)
+
+		backoff = backoff * 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// StatusCode returns the HTTP status code associated with an error returned by the New Relic client.
+func StatusCode(err error) (int, bool) {
+	var response *ResponseError
+	if errors.As(err, &response) {
+		return response.StatusCode, true
+	}
+
+	var unexpected *nrerrors.UnexpectedStatusCode
+	if !errors.As(err, &unexpected) {
+		return 0, false
+	}
+
+	matches := statusCodeRegex.FindStringSubmatch(unexpected.Error())
+	if len(matches) != 2 {
+		return 0, false
+	}
+
+	code, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return code, true
+}
+
+// Helper function to convert an error into a status used when recording metrics.
+func status(err error) string {
+	if err == nil {
+		return "success"
+	}
+
+	var notFound *nrerrors.NotFound
+	if errors.As(err, &notFound) {
+		return strconv.Itoa(http.StatusNotFound)
+	}
+
+	if code, ok := StatusCode(err); ok {
+		return strconv.Itoa(code)
+	}
+
+	return "error"
+}
+
+// Calls returns the total number of API calls which have been made.
+func (c *Client) Calls() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var total int
+
+	for _, count := range c.calls {
+		total += count
+	}
+
+	return total
+}
+
+// LogCalls prints a summary of the API calls which have been made.
+func (c *Client) LogCalls() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var endpoints []string
+
+	for endpoint := range c.calls {
+		endpoints = append(endpoints, endpoint)
+	}
+
+	sort.Strings(endpoints)
+
+	fields := log.Fields{}
+
+	var total int
+
+	for _, endpoint := range endpoints {
+		fields[endpoint] = c.calls[endpoint]
+		total += c.calls[endpoint]
+	}
+
+	log.WithFields(fields).Infoln("New Relic API calls made:", total)
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/fake"
+)
+
+func newClient(t *testing.T, server *fake.Server) *Client {
+	client, err := New(Limits{Retries: 2, Backoff: time.Millisecond}, server.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// Helper function to list monitors, which goes through the Synthetics client.
+func list(client *Client) error {
+	return client.Call("Synthetics.ListMonitors", func() error {
+		_, err := client.Synthetics.ListMonitors(&synthetics.ListMonitorsParams{})
+		return err
+	})
+}
+
+func TestCallRetries(t *testing.T) {
+	tests := []struct {
+		status   int
+		requests int
+		fails    bool
+	}{
+		{status: http.StatusTooManyRequests, requests: 2},
+		{status: http.StatusBadGateway, requests: 2},
+		{status: http.StatusServiceUnavailable, requests: 2},
+		{status: http.StatusGatewayTimeout, requests: 2},
+		// Synthetics returns validation errors as a 500, so they fail straight away.
+		{status: http.StatusInternalServerError, requests: 1, fails: true},
+		{status: http.StatusBadRequest, requests: 1, fails: true},
+	}
+
+	for _, test := range tests {
+		server := fake.New(1)
+
+		server.Fail(fake.OperationListMonitors, test.status, 1)
+
+		err := list(newClient(t, server))
+		if (err != nil) != test.fails {
+			t.Errorf("%d: unexpected error %v", test.status, err)
+		}
+
+		// The New Relic client would retry on its own if its retries weren't disabled.
+		if requests := server.Requests(fake.OperationListMonitors); requests != test.requests {
+			t.Errorf("%d: expected %d requests, got %d", test.status, test.requests, requests)
+		}
+
+		server.Close()
+	}
+}
+
+func TestCallGivesUp(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	server.Fail(fake.OperationListMonitors, http.StatusServiceUnavailable, 5)
+
+	err := list(newClient(t, server))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if code, ok := StatusCode(err); !ok || code != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, code)
+	}
+
+	if requests := server.Requests(fake.OperationListMonitors); requests != 3 {
+		t.Errorf("expected the call and 2 retries, got %d requests", requests)
+	}
+}
+
+func TestCreateIsNotRetried(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	server.Fail(fake.OperationListMonitors, http.StatusServiceUnavailable, 1)
+
+	client := newClient(t, server)
+
+	err := client.Create("Synthetics.ListMonitors", func() error {
+		_, err := client.Synthetics.ListMonitors(&synthetics.ListMonitorsParams{})
+		return err
+	})
+	if !Ambiguous(err) {
+		t.Errorf("expected an ambiguous error, got %v", err)
+	}
+
+	if requests := server.Requests(fake.OperationListMonitors); requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2020, 11, 24, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		header string
+		want   time.Duration
+	}{
+		{header: "", want: 0},
+		{header: "0", want: 0},
+		{header: "30", want: 30 * time.Second},
+		{header: now.Add(time.Minute).Format(http.TimeFormat), want: time.Minute},
+		{header: "soon", want: 0},
+	}
+
+	for _, test := range tests {
+		if got := retryAfter(test.header, now); got != test.want {
+			t.Errorf("%q: expected %s, got %s", test.header, test.want, got)
+		}
+	}
+
+	after, ok := RetryAfter(&ResponseError{StatusCode: http.StatusTooManyRequests, RetryAfter: time.Second})
+	if !ok || after != time.Second {
+		t.Errorf("expected a Retry-After of 1s, got %s", after)
+	}
+}
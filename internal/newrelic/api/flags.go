@@ -0,0 +1,13 @@
+package api
+
+import (
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// Flags which configure the limits applied to New Relic API calls.
+func (l *Limits) Flags(command *kingpin.CmdClause) {
+	command.Flag("new-relic-rate-limit", "Maximum number of New Relic API calls per second").Envar("NEW_RELIC_RATE_LIMIT").Default("10").Float64Var(&l.Rate)
+	command.Flag("new-relic-rate-burst", "Number of New Relic API calls which may burst above the rate limit").Envar("NEW_RELIC_RATE_BURST").Default("5").IntVar(&l.Burst)
+	command.Flag("new-relic-retries", "Number of retries for New Relic API calls which were rate limited, timed out or found New Relic unavailable. Creates are never retried").Envar("NEW_RELIC_RETRIES").Default("5").IntVar(&l.Retries)
+	command.Flag("new-relic-backoff", "Backoff before retrying a New Relic API call, doubled for each retry. A Retry-After from New Relic is used instead when present").Envar("NEW_RELIC_BACKOFF").Default("2s").DurationVar(&l.Backoff)
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/newrelic"
+	nrerrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+)
+
+// ResponseError is returned for a response which may be retried, along with how long New Relic asked us to wait.
+type ResponseError struct {
+	StatusCode int
+	// RetryAfter is zero when the response didn't have a Retry-After header.
+	RetryAfter time.Duration
+}
+
+// Error describes the response in the same way as the New Relic client.
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%d response returned: %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// Retryable returns true if an error was caused by rate limiting, an unavailable gateway or a timeout.
+// Other server errors aren't retried because the Synthetics API returns validation errors as a 500.
+func Retryable(err error) bool {
+	if Timeout(err) {
+		return true
+	}
+
+	code, ok := StatusCode(err)
+	if !ok {
+		return false
+	}
+
+	return code == http.StatusTooManyRequests || Unavailable(code)
+}
+
+// Ambiguous returns true if a call failed in a way where New Relic may still have made the change,
+// so a call which isn't safe to retry needs to check for the change instead.
+func Ambiguous(err error) bool {
+	if Timeout(err) {
+		return true
+	}
+
+	code, ok := StatusCode(err)
+
+	return ok && Unavailable(code)
+}
+
+// Timeout returns true if an error was caused by a call timing out.
+func Timeout(err error) bool {
+	var timeout *nrerrors.Timeout
+	if errors.As(err, &timeout) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// Unavailable returns true for the status codes returned when New Relic is unavailable.
+func Unavailable(code int) bool {
+	return code == http.StatusBadGateway || code == http.StatusServiceUnavailable || code == http.StatusGatewayTimeout
+}
+
+// RetryAfter returns how long New Relic asked us to wait before retrying a call.
+func RetryAfter(err error) (time.Duration, bool) {
+	var response *ResponseError
+	if !errors.As(err, &response) || response.RetryAfter <= 0 {
+		return 0, false
+	}
+
+	return response.RetryAfter, true
+}
+
+// Options which turn off the retries made by the New Relic client, so calls are only retried by Call where
+// they are rate limited and only when it is safe to do so.
+func retryOptions() []newrelic.ConfigOption {
+	return []newrelic.ConfigOption{
+		newrelic.ConfigRetryMax(0),
+		newrelic.ConfigCheckRetry(checkRetry),
+	}
+}
+
+// Helper function used by the New Relic client to check responses, which never retries. Responses which Call can retry
+// are returned as a ResponseError, because the New Relic client doesn't keep the response headers.
+func checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if err != nil || resp == nil {
+		return false, nil
+	}
+
+	if resp.StatusCode != http.StatusTooManyRequests && !Unavailable(resp.StatusCode) {
+		return false, nil
+	}
+
+	return false, &ResponseError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: retryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
+
+// Helper function to parse a Retry-After header, which is either a number of seconds or a date.
+func retryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return date.Sub(now)
+	}
+
+	return 0
+}
@@ -0,0 +1,75 @@
+package condition
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+)
+
+// Ensure a Synthetics alert condition exists in a policy for a monitor.
+func Ensure(client *api.Client, policyID int, condition alerts.SyntheticsCondition) (*alerts.SyntheticsCondition, error) {
+	var list []*alerts.SyntheticsCondition
+
+	err := client.Call("Alerts.ListSyntheticsConditions", func() error {
+		var err error
+		list, err = client.Alerts.ListSyntheticsConditions(policyID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var c *alerts.SyntheticsCondition
+
+	for _, existing := range list {
+		if existing.MonitorID != condition.MonitorID {
+			continue
+		}
+
+		if existing.Name == condition.Name && existing.Enabled == condition.Enabled && existing.RunbookURL == condition.RunbookURL {
+			return existing, nil
+		}
+
+		condition.ID = existing.ID
+
+		err = client.Call("Alerts.UpdateSyntheticsCondition", func() error {
+			c, err = client.Alerts.UpdateSyntheticsCondition(condition)
+			return err
+		})
+
+		return c, err
+	}
+
+	err = client.Create("Alerts.CreateSyntheticsCondition", func() error {
+		c, err = client.Alerts.CreateSyntheticsCondition(policyID, condition)
+		return err
+	})
+	if err != nil && api.Ambiguous(err) {
+		return findCreated(client, policyID, condition, err)
+	}
+
+	return c, err
+}
+
+// Helper function to look for a condition after a create failed in a way where it may still have been created,
+// instead of retrying the create and ending up with a duplicate.
+func findCreated(client *api.Client, policyID int, condition alerts.SyntheticsCondition, cause error) (*alerts.SyntheticsCondition, error) {
+	var list []*alerts.SyntheticsCondition
+
+	err := client.Call("Alerts.ListSyntheticsConditions", func() error {
+		var err error
+		list, err = client.Alerts.ListSyntheticsConditions(policyID)
+		return err
+	})
+	if err != nil {
+		return nil, cause
+	}
+
+	for _, existing := range list {
+		if existing.MonitorID == condition.MonitorID && existing.Name == condition.Name {
+			return existing, nil
+		}
+	}
+
+	return nil, cause
+}
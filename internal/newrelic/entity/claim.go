@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// Claim recorded on a monitor by the cluster which owns it.
+type Claim struct {
+	Cluster   string
+	Heartbeat time.Time
+}
+
+// Claims configures how this cluster claims the monitors which it manages, so that
+// clusters serving the same hostnames (eg. active/passive DR) do not fight over a monitor.
+type Claims struct {
+	Cluster    string
+	StaleAfter time.Duration
+}
+
+// Flags which configure claims for a command.
+func (c *Claims) Flags(command *kingpin.CmdClause) {
+	command.Flag("cluster-id", "Identifier of this cluster, enables claiming monitors so only one cluster manages each").Envar("CLUSTER_ID").StringVar(&c.Cluster)
+	command.Flag("claim-stale-after", "Duration after the owner's last heartbeat which another cluster may take over a monitor").Envar("CLAIM_STALE_AFTER").Default("1h").DurationVar(&c.StaleAfter)
+}
+
+// Enabled returns true if this cluster claims monitors.
+func (c Claims) Enabled() bool {
+	return c.Cluster != ""
+}
+
+// GetClaim returns the claim recorded in a monitor's tags.
+func GetClaim(tags []*entities.Tag) (Claim, bool) {
+	cluster, ok := TagValue(tags, TagClaimCluster)
+	if !ok {
+		return Claim{}, false
+	}
+
+	claim := Claim{
+		Cluster: cluster,
+	}
+
+	if value, ok := TagValue(tags, TagClaimHeartbeat); ok {
+		// An unparsable heartbeat is treated as stale.
+		claim.Heartbeat, _ = time.Parse(time.RFC3339, value)
+	}
+
+	return claim, true
+}
+
+// Check if this cluster may manage a monitor. Monitors are available if they are unclaimed, already
+// claimed by this cluster or the owning cluster has not sent a heartbeat within the stale duration.
+func (c Claims) Check(tags []*entities.Tag, now time.Time) (bool, string) {
+	if !c.Enabled() {
+		return true, ""
+	}
+
+	claim, ok := GetClaim(tags)
+	if !ok || claim.Cluster == c.Cluster {
+		return true, ""
+	}
+
+	if now.Sub(claim.Heartbeat) > c.StaleAfter {
+		return true, fmt.Sprintf("taking over from stale cluster %s", claim.Cluster)
+	}
+
+	return false, fmt.Sprintf("claimed by cluster %s, last heartbeat %s", claim.Cluster, claim.Heartbeat.Format(time.RFC3339))
+}
+
+// Tags which record this cluster's claim on a monitor.
+// An existing heartbeat is kept until it is halfway to stale so tags are not rewritten on every run.
+func (c Claims) Tags(tags []*entities.Tag, now time.Time) []entities.Tag {
+	if !c.Enabled() {
+		return nil
+	}
+
+	heartbeat := now
+
+	if claim, ok := GetClaim(tags); ok && claim.Cluster == c.Cluster && now.Sub(claim.Heartbeat) < c.StaleAfter/2 {
+		heartbeat = claim.Heartbeat
+	}
+
+	return []entities.Tag{
+		{
+			Key:    TagClaimCluster,
+			Values: []string{c.Cluster},
+		},
+		{
+			Key:    TagClaimHeartbeat,
+			Values: []string{heartbeat.UTC().Format(time.RFC3339)},
+		},
+	}
+}
@@ -9,6 +9,26 @@ const (
 	TagOpenShiftRouteToKind = "openshiftRouteToKind"
 	// TagOpenShiftRouteToName is used to identify the OpenShift Route "To" Name.
 	TagOpenShiftRouteToName = "openshiftRouteToName"
+	// TagOpenShiftRouteOwner is used to identify every OpenShift Route which shares a Monitor, as namespace/name.
+	TagOpenShiftRouteOwner = "openshiftRouteOwner"
+	// TagSyntheticMonitorNamespace is used to identify the SyntheticMonitor Namespace for a Monitor.
+	TagSyntheticMonitorNamespace = "syntheticMonitorNamespace"
+	// TagSyntheticMonitorName is used to identify the SyntheticMonitor Name for a Monitor.
+	TagSyntheticMonitorName = "syntheticMonitorName"
+	// TagClaimCluster is used to identify the cluster which owns a Monitor.
+	TagClaimCluster = "claimCluster"
+	// TagClaimHeartbeat is used to record when the owning cluster last synced a Monitor.
+	TagClaimHeartbeat = "claimHeartbeat"
+	// TagTombstone is used to record when an orphaned Monitor was disabled ahead of being deleted.
+	TagTombstone = "tombstonedAt"
+	// TagInventory is used to record the alert conditions which were created for a Monitor.
+	TagInventory = "inventory"
+	// TagDriftAccepted is used to record edits made outside of this tool which were accepted, as field=desired value.
+	TagDriftAccepted = "driftAccepted"
+	// TagDoNotDelete is added by users to protect a Monitor from being deleted.
+	TagDoNotDelete = "do-not-delete"
+	// TagDoNotDeleteAlias is also accepted in place of TagDoNotDelete.
+	TagDoNotDeleteAlias = "doNotDelete"
 
 	// TypeMonitor is used to search for monitors.
 	TypeMonitor = "MONITOR"
@@ -1,8 +1,35 @@
 package entity
 
-import "github.com/newrelic/newrelic-client-go/pkg/entities"
+import (
+	"fmt"
 
-func GetNamespaceName(entity []*entities.Tag) (string, string, error) {
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+)
 
-	return "", "", nil
+// GetNamespaceName returns the OpenShift Route namespace and name which a monitor was provisioned for.
+func GetNamespaceName(tags []*entities.Tag) (string, string, error) {
+	namespace, ok := TagValue(tags, TagOpenShiftRouteNamespace)
+	if !ok {
+		return "", "", fmt.Errorf("tag not found: %s", TagOpenShiftRouteNamespace)
+	}
+
+	name, ok := TagValue(tags, TagOpenShiftRouteName)
+	if !ok {
+		return "", "", fmt.Errorf("tag not found: %s", TagOpenShiftRouteName)
+	}
+
+	return namespace, name, nil
+}
+
+// TagValue returns the first value for a tag key.
+func TagValue(tags []*entities.Tag, key string) (string, bool) {
+	for _, tag := range tags {
+		if tag == nil || tag.Key != key || len(tag.Values) == 0 {
+			continue
+		}
+
+		return tag.Values[0], true
+	}
+
+	return "", false
 }
@@ -0,0 +1,180 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	routev1 "github.com/openshift/api/route/v1"
+)
+
+// Owner is an OpenShift Route which a monitor was provisioned for.
+type Owner struct {
+	Namespace string
+	Name      string
+}
+
+// String returns the owner as namespace/name.
+func (o Owner) String() string {
+	return fmt.Sprintf("%s/%s", o.Namespace, o.Name)
+}
+
+// RouteOwner returns the owner for a Route.
+func RouteOwner(route routev1.Route) Owner {
+	return Owner{
+		Namespace: route.ObjectMeta.Namespace,
+		Name:      route.ObjectMeta.Name,
+	}
+}
+
+// Owners returns every Route which shares a monitor, sorted by namespace and name.
+// Monitors which were tagged before owners were recorded fall back to the Route namespace and name tags.
+func Owners(tags []*entities.Tag) []Owner {
+	var owners []Owner
+
+	for _, tag := range tags {
+		if tag == nil || tag.Key != TagOpenShiftRouteOwner {
+			continue
+		}
+
+		for _, value := range tag.Values {
+			sep := strings.Index(value, "/")
+			if sep < 1 {
+				continue
+			}
+
+			owners = append(owners, Owner{
+				Namespace: value[:sep],
+				Name:      value[sep+1:],
+			})
+		}
+	}
+
+	if len(owners) == 0 {
+		namespace, name, err := GetNamespaceName(tags)
+		if err != nil {
+			return nil
+		}
+
+		owners = append(owners, Owner{
+			Namespace: namespace,
+			Name:      name,
+		})
+	}
+
+	sortOwners(owners)
+
+	return owners
+}
+
+// ForeignOwners returns the owners of a monitor which belong to other namespaces.
+func ForeignOwners(tags []*entities.Tag, namespace string) []Owner {
+	var owners []Owner
+
+	for _, owner := range Owners(tags) {
+		if owner.Namespace != namespace {
+			owners = append(owners, owner)
+		}
+	}
+
+	return owners
+}
+
+// OwnerTags which identify every Route sharing a monitor. The owners from other namespaces are kept from the
+// current tags because they are reconciled by the runs for their own namespace, while the owners from this
+// namespace are replaced by the given Routes.
+func OwnerTags(current []*entities.Tag, namespace string, routes []routev1.Route) []entities.Tag {
+	foreign := ForeignOwners(current, namespace)
+
+	owners := append([]Owner(nil), foreign...)
+
+	var kinds, names []string
+
+	for _, route := range routes {
+		owners = append(owners, RouteOwner(route))
+		kinds = appendUnique(kinds, route.Spec.To.Kind)
+		names = appendUnique(names, route.Spec.To.Name)
+	}
+
+	// The targets of Routes in other namespaces are only known from the current tags.
+	if len(foreign) > 0 {
+		for _, tag := range current {
+			if tag == nil {
+				continue
+			}
+
+			for _, value := range tag.Values {
+				switch tag.Key {
+				case TagOpenShiftRouteToKind:
+					kinds = appendUnique(kinds, value)
+				case TagOpenShiftRouteToName:
+					names = appendUnique(names, value)
+				}
+			}
+		}
+	}
+
+	sortOwners(owners)
+
+	var namespaces, routeNames, values []string
+
+	for _, owner := range owners {
+		namespaces = appendUnique(namespaces, owner.Namespace)
+		routeNames = appendUnique(routeNames, owner.Name)
+		values = appendUnique(values, owner.String())
+	}
+
+	var tags []entities.Tag
+
+	for _, tag := range []entities.Tag{
+		{Key: TagOpenShiftRouteNamespace, Values: namespaces},
+		{Key: TagOpenShiftRouteName, Values: routeNames},
+		{Key: TagOpenShiftRouteToKind, Values: kinds},
+		{Key: TagOpenShiftRouteToName, Values: names},
+		{Key: TagOpenShiftRouteOwner, Values: values},
+	} {
+		if len(tag.Values) > 0 {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// Replace the values of the keys in the replacement, keeping every other tag.
+func Replace(current []*entities.Tag, replacement []entities.Tag) []entities.Tag {
+	keys := make(map[string]bool, len(replacement))
+
+	for _, tag := range replacement {
+		keys[tag.Key] = true
+	}
+
+	var tags []entities.Tag
+
+	for _, tag := range current {
+		if tag == nil || keys[tag.Key] {
+			continue
+		}
+
+		tags = append(tags, *tag)
+	}
+
+	return append(tags, replacement...)
+}
+
+// Helper function to sort owners by namespace and name.
+func sortOwners(owners []Owner) {
+	sort.Slice(owners, func(i, j int) bool {
+		return owners[i].String() < owners[j].String()
+	})
+}
+
+// Helper function to append a value to a list if it is not already present.
+func appendUnique(list []string, value string) []string {
+	if value == "" || contains(list, value) {
+		return list
+	}
+
+	return append(list, value)
+}
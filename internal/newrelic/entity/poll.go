@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// Polling for monitors which were just created, because their entities are indexed some time after the monitor.
+// Monitors aren't polled for when the timeout is zero.
+type Polling struct {
+	Timeout time.Duration
+	Backoff time.Duration
+}
+
+// Flags which configure polling for a command.
+func (p *Polling) Flags(command *kingpin.CmdClause) {
+	command.Flag("entity-poll-timeout", "How long to wait for new monitors to be indexed before their tags are left as pending").Envar("ENTITY_POLL_TIMEOUT").Default("30s").DurationVar(&p.Timeout)
+	command.Flag("entity-poll-backoff", "Delay before polling for new monitors again, which doubles after each poll").Envar("ENTITY_POLL_BACKOFF").Default("2s").DurationVar(&p.Backoff)
+}
+
+// Helper function to retry with backoff until nothing is missing or the timeout is reached.
+func (p Polling) poll(missing func() int, retry func() error) error {
+	if p.Timeout <= 0 {
+		return nil
+	}
+
+	delay := p.Backoff
+	if delay <= 0 {
+		delay = time.Second
+	}
+
+	deadline := time.Now().Add(p.Timeout)
+
+	for missing() > 0 {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			log.WithField("missing", missing()).Warnln("Gave up waiting for monitor entities to be indexed")
+			return nil
+		}
+
+		if delay > remaining {
+			delay = remaining
+		}
+
+		log.WithFields(log.Fields{
+			"missing": missing(),
+			"delay":   delay,
+		}).Infoln("Waiting for monitor entities to be indexed")
+
+		time.Sleep(delay)
+
+		err := retry()
+		if err != nil {
+			return err
+		}
+
+		delay *= 2
+	}
+
+	return nil
+}
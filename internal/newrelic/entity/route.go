@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	routev1 "github.com/openshift/api/route/v1"
+)
+
+// RouteTags which identify the Route a monitor was provisioned for.
+func RouteTags(route routev1.Route) []entities.Tag {
+	return []entities.Tag{
+		{
+			Key:    TagOpenShiftRouteNamespace,
+			Values: []string{route.ObjectMeta.Namespace},
+		},
+		{
+			Key:    TagOpenShiftRouteName,
+			Values: []string{route.ObjectMeta.Name},
+		},
+		{
+			Key:    TagOpenShiftRouteToKind,
+			Values: []string{route.Spec.To.Kind},
+		},
+		{
+			Key:    TagOpenShiftRouteToName,
+			Values: []string{route.Spec.To.Name},
+		},
+		{
+			Key:    TagOpenShiftRouteOwner,
+			Values: []string{RouteOwner(route).String()},
+		},
+	}
+}
+
+// Managed returns true if a monitor was provisioned by this tool, for either a Route or a SyntheticMonitor.
+func Managed(tags []*entities.Tag) bool {
+	for _, key := range []string{TagOpenShiftRouteNamespace, TagSyntheticMonitorNamespace} {
+		if _, ok := TagValue(tags, key); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,294 @@
+package entity
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+)
+
+// Entity is a Synthetics monitor entity along with its tags.
+type Entity struct {
+	AccountID int             `json:"accountId"`
+	GUID      string          `json:"guid"`
+	Name      string          `json:"name"`
+	Permalink string          `json:"permalink"`
+	Tags      []*entities.Tag `json:"tags"`
+}
+
+const (
+	// The maximum number of GUIDs which can be requested in a single entities query.
+	getBatchSize = 25
+
+	searchQuery = `query($query: String!, $cursor: String) {
+		actor {
+			entitySearch(query: $query) {
+				results(cursor: $cursor) {
+					nextCursor
+					entities { accountId guid name permalink tags { key values } }
+				}
+			}
+		}
+	}`
+
+	getQuery = `query($guids: [EntityGuid]!) {
+		actor {
+			entities(guids: $guids) { accountId guid name permalink tags { key values } }
+		}
+	}`
+)
+
+type searchResponse struct {
+	Actor struct {
+		EntitySearch struct {
+			Results struct {
+				NextCursor *string
+				Entities   []*Entity
+			}
+		}
+	}
+}
+
+type getResponse struct {
+	Actor struct {
+		Entities []*Entity
+	}
+}
+
+// Search for monitor entities which belong to a namespace.
+func Search(client *api.Client, namespace string) ([]*Entity, error) {
+	return SearchTag(client, TagOpenShiftRouteNamespace, namespace)
+}
+
+// SearchTag returns the monitor entities which have a tag set to a value.
+func SearchTag(client *api.Client, key, value string) ([]*Entity, error) {
+	return search(client, fmt.Sprintf("%s AND tags.%s = '%s'", monitorQuery, key, escape(value)))
+}
+
+// SearchNames returns the monitor entities which match a list of names.
+func SearchNames(client *api.Client, names []string) ([]*Entity, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	quoted := make([]string, len(names))
+
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("'%s'", escape(name))
+	}
+
+	return search(client, fmt.Sprintf("%s AND name IN (%s)", monitorQuery, strings.Join(quoted, ", ")))
+}
+
+// Get monitor entities by GUID, batching requests to keep the number of API calls low.
+// GUIDs which have not been indexed yet are omitted from the result.
+func Get(client *api.Client, guids []string) ([]*Entity, error) {
+	var list []*Entity
+
+	for start := 0; start < len(guids); start += getBatchSize {
+		end := start + getBatchSize
+		if end > len(guids) {
+			end = len(guids)
+		}
+
+		var resp getResponse
+
+		err := client.Call("NerdGraph.entities", func() error {
+			return client.NerdGraph.QueryWithResponse(getQuery, map[string]interface{}{
+				"guids": guids[start:end],
+			}, &resp)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entity := range resp.Actor.Entities {
+			if entity != nil {
+				list = append(list, entity)
+			}
+		}
+	}
+
+	return list, nil
+}
+
+// Lookup the entities for monitors which have been synced, keyed by name with the monitor ID as the value.
+// Lookups are narrowed as much as possible to avoid scanning every monitor in the account, starting with
+// monitors which have already been tagged with the given key and value.
+func Lookup(client *api.Client, key, value string, ids map[string]string) ([]*Entity, error) {
+	return Polling{}.Lookup(client, key, value, ids)
+}
+
+// Lookup the entities for monitors which have been synced, polling for the monitors which have not been indexed yet.
+func (p Polling) Lookup(client *api.Client, key, value string, ids map[string]string) ([]*Entity, error) {
+	var found []*Entity
+
+	seen := make(map[string]bool, len(ids))
+
+	collect := func(list []*Entity) {
+		for _, entity := range list {
+			id, ok := ids[entity.Name]
+			if !ok || seen[entity.Name] {
+				continue
+			}
+
+			if monitorID, err := MonitorID(entity.GUID); err != nil || monitorID != id {
+				continue
+			}
+
+			seen[entity.Name] = true
+			found = append(found, entity)
+		}
+	}
+
+	missing := func() []string {
+		var names []string
+
+		for name := range ids {
+			if !seen[name] {
+				names = append(names, name)
+			}
+		}
+
+		sort.Strings(names)
+
+		return names
+	}
+
+	// Monitors which have been tagged by a previous run.
+	existing, err := SearchTag(client, key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	collect(existing)
+
+	accountID, ok := AccountID(existing)
+	if client.AccountID != 0 {
+		accountID, ok = client.AccountID, true
+	}
+
+	untagged := func() error {
+		// Monitors which have not been tagged yet, using GUIDs derived from the create/update results.
+		if ok && len(missing()) > 0 {
+			var guids []string
+
+			for _, name := range missing() {
+				guids = append(guids, GUID(accountID, ids[name]))
+			}
+
+			list, err := Get(client, guids)
+			if err != nil {
+				return err
+			}
+
+			collect(list)
+		}
+
+		// Fallback to searching by name when the account could not be determined.
+		if len(missing()) > 0 {
+			list, err := SearchNames(client, missing())
+			if err != nil {
+				return err
+			}
+
+			collect(list)
+		}
+
+		return nil
+	}
+
+	err = untagged()
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.poll(func() int {
+		return len(missing())
+	}, untagged)
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+// GUID returns the entity GUID for a Synthetics monitor.
+func GUID(accountID int, monitorID string) string {
+	return strings.TrimRight(base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%d|SYNTH|MONITOR|%s", accountID, monitorID))), "=")
+}
+
+// MonitorID returns the Synthetics monitor ID for an entity GUID.
+func MonitorID(guid string) (string, error) {
+	decoded, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(guid, "="))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode entity guid: %w", err)
+	}
+
+	parts := strings.Split(string(decoded), "|")
+
+	if len(parts) != 4 || parts[1] != "SYNTH" || parts[2] != TypeMonitor {
+		return "", fmt.Errorf("entity guid is not a monitor: %s", guid)
+	}
+
+	return parts[3], nil
+}
+
+// AccountID returns the account which a list of entities belongs to.
+func AccountID(list []*Entity) (int, bool) {
+	for _, entity := range list {
+		if entity.AccountID != 0 {
+			return entity.AccountID, true
+		}
+	}
+
+	return 0, false
+}
+
+// The base query used for finding Synthetics monitors.
+var monitorQuery = fmt.Sprintf("domain = 'SYNTH' AND type = '%s'", TypeMonitor)
+
+// Helper function to paginate through an entity search query.
+func search(client *api.Client, query string) ([]*Entity, error) {
+	var (
+		list   []*Entity
+		cursor *string
+	)
+
+	// Avoid matching monitors in other accounts which the API key has access to.
+	if client.AccountID != 0 {
+		query = fmt.Sprintf("accountId = %d AND %s", client.AccountID, query)
+	}
+
+	for {
+		var resp searchResponse
+
+		err := client.Call("NerdGraph.entitySearch", func() error {
+			return client.NerdGraph.QueryWithResponse(searchQuery, map[string]interface{}{
+				"query":  query,
+				"cursor": cursor,
+			}, &resp)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, resp.Actor.EntitySearch.Results.Entities...)
+
+		cursor = resp.Actor.EntitySearch.Results.NextCursor
+		if cursor == nil || *cursor == "" {
+			break
+		}
+	}
+
+	return list, nil
+}
+
+// Helper function to escape a value used in an entity search query.
+func escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+}
@@ -0,0 +1,169 @@
+package entity
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+)
+
+// ManagedTags are the tag keys which are owned by this tool.
+// Tags using any other key are left untouched during reconciliation.
+var ManagedTags = []string{
+	TagOpenShiftRouteNamespace,
+	TagOpenShiftRouteName,
+	TagOpenShiftRouteToKind,
+	TagOpenShiftRouteToName,
+	TagOpenShiftRouteOwner,
+	TagSyntheticMonitorNamespace,
+	TagSyntheticMonitorName,
+	TagClaimCluster,
+	TagClaimHeartbeat,
+	TagTombstone,
+	TagInventory,
+	TagDriftAccepted,
+}
+
+// TagChanges required to converge an entity's managed tags.
+type TagChanges struct {
+	// Add are the values which are desired but not yet applied.
+	Add []entities.Tag
+	// DeleteValues are the values which are applied but no longer desired.
+	DeleteValues []entities.TagValue
+	// DeleteKeys are the managed keys which are no longer desired at all.
+	DeleteKeys []string
+}
+
+// Empty returns true if no changes are required.
+func (c TagChanges) Empty() bool {
+	return len(c.Add) == 0 && len(c.DeleteValues) == 0 && len(c.DeleteKeys) == 0
+}
+
+// DiffTags computes the changes required to converge the managed keys of the current tags to the desired tags.
+func DiffTags(current []*entities.Tag, desired []entities.Tag) TagChanges {
+	var changes TagChanges
+
+	have := tagMap(current)
+
+	want := make(map[string][]string, len(desired))
+	for _, tag := range desired {
+		want[tag.Key] = append(want[tag.Key], tag.Values...)
+	}
+
+	for _, key := range ManagedTags {
+		values, ok := want[key]
+		if !ok {
+			if _, ok := have[key]; ok {
+				changes.DeleteKeys = append(changes.DeleteKeys, key)
+			}
+
+			continue
+		}
+
+		var add []string
+
+		for _, value := range values {
+			if !contains(have[key], value) {
+				add = append(add, value)
+			}
+		}
+
+		if len(add) > 0 {
+			changes.Add = append(changes.Add, entities.Tag{
+				Key:    key,
+				Values: add,
+			})
+		}
+
+		for _, value := range have[key] {
+			if !contains(values, value) {
+				changes.DeleteValues = append(changes.DeleteValues, entities.TagValue{
+					Key:   key,
+					Value: value,
+				})
+			}
+		}
+	}
+
+	return changes
+}
+
+// ReconcileTags converges the managed tags on an entity to the desired set.
+func ReconcileTags(client *api.Client, guid string, current []*entities.Tag, desired []entities.Tag) (TagChanges, error) {
+	changes := DiffTags(current, desired)
+	if changes.Empty() {
+		return changes, nil
+	}
+
+	// When every tag on the entity is managed by us we can converge in a single call.
+	if !hasUnmanaged(current) {
+		return changes, client.Call("NerdGraph.taggingReplaceTagsOnEntity", func() error {
+			return client.Entities.ReplaceTags(guid, desired)
+		})
+	}
+
+	if len(changes.DeleteKeys) > 0 {
+		err := client.Call("NerdGraph.taggingDeleteTagFromEntity", func() error {
+			return client.Entities.DeleteTags(guid, changes.DeleteKeys)
+		})
+		if err != nil {
+			return changes, err
+		}
+	}
+
+	if len(changes.DeleteValues) > 0 {
+		err := client.Call("NerdGraph.taggingDeleteTagValuesFromEntity", func() error {
+			return client.Entities.DeleteTagValues(guid, changes.DeleteValues)
+		})
+		if err != nil {
+			return changes, err
+		}
+	}
+
+	if len(changes.Add) > 0 {
+		err := client.Call("NerdGraph.taggingAddTagsToEntity", func() error {
+			return client.Entities.AddTags(guid, changes.Add)
+		})
+		if err != nil {
+			return changes, err
+		}
+	}
+
+	return changes, nil
+}
+
+// Helper function to convert a list of tags into a map of values keyed by tag key.
+func tagMap(tags []*entities.Tag) map[string][]string {
+	m := make(map[string][]string, len(tags))
+
+	for _, tag := range tags {
+		if tag == nil {
+			continue
+		}
+
+		m[tag.Key] = append(m[tag.Key], tag.Values...)
+	}
+
+	return m
+}
+
+// Helper function to check if a set of tags contains keys which are not managed by this tool.
+func hasUnmanaged(tags []*entities.Tag) bool {
+	for _, tag := range tags {
+		if tag != nil && !contains(ManagedTags, tag.Key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper function to check if a list contains a value.
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// Tombstones configures whether orphaned monitors are disabled for a grace period before they are deleted,
+// so a monitor can be recovered if its Route was removed by mistake.
+type Tombstones struct {
+	GracePeriod time.Duration
+}
+
+// Flags which configure tombstones for a command.
+func (t *Tombstones) Flags(command *kingpin.CmdClause) {
+	command.Flag("tombstone-grace-period", "Disable orphaned monitors and only delete them once they have been disabled for this long eg. 72h").Envar("TOMBSTONE_GRACE_PERIOD").DurationVar(&t.GracePeriod)
+}
+
+// Enabled returns true if orphaned monitors are disabled before they are deleted.
+func (t Tombstones) Enabled() bool {
+	return t.GracePeriod > 0
+}
+
+// GetTombstone returns when a monitor was disabled ahead of being deleted.
+// An unparsable tombstone is ignored so that the grace period starts again.
+func GetTombstone(tags []*entities.Tag) (time.Time, bool) {
+	value, ok := TagValue(tags, TagTombstone)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	tombstoned, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return tombstoned, true
+}
+
+// Tags which record a tombstone, along with the managed tags which are already applied to the monitor.
+func (t Tombstones) Tags(tags []*entities.Tag, now time.Time) []entities.Tag {
+	var desired []entities.Tag
+
+	for _, tag := range tags {
+		if tag == nil || tag.Key == TagTombstone || !contains(ManagedTags, tag.Key) {
+			continue
+		}
+
+		desired = append(desired, *tag)
+	}
+
+	return append(desired, entities.Tag{
+		Key:    TagTombstone,
+		Values: []string{now.UTC().Format(time.RFC3339)},
+	})
+}
+
+// DoNotDeleteTags are the tag keys which protect a monitor from being deleted.
+var DoNotDeleteTags = []string{
+	TagDoNotDelete,
+	TagDoNotDeleteAlias,
+}
+
+// Protected returns true if the monitor has been tagged to protect it from being deleted.
+// Any value other than "false" protects the monitor.
+func Protected(tags []*entities.Tag) bool {
+	for _, key := range DoNotDeleteTags {
+		value, ok := TagValue(tags, key)
+		if ok && !strings.EqualFold(value, "false") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,136 @@
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+)
+
+// Body of the Synthetics alert condition requests and responses.
+type conditionBody struct {
+	Condition alerts.SyntheticsCondition `json:"synthetics_condition"`
+}
+
+// Helper function to list the Synthetics alert conditions in a policy.
+func (s *Server) handleConditions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status, ok := s.record(OperationListConditions); ok {
+		fail(w, status)
+		return
+	}
+
+	policyID, _ := strconv.Atoi(r.URL.Query().Get("policy_id"))
+
+	list := []alerts.SyntheticsCondition{}
+
+	for id, condition := range s.conds {
+		if s.policies[id] == policyID {
+			list = append(list, *condition)
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+
+	respond(w, http.StatusOK, map[string]interface{}{
+		"synthetics_conditions": list,
+	})
+}
+
+// Helper function to create, update or delete a Synthetics alert condition.
+func (s *Server) handleCondition(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v2/alerts_synthetics_conditions/"), ".json")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if strings.HasPrefix(path, "policies/") && r.Method == http.MethodPost {
+		if status, ok := s.record(OperationCreateCondition); ok {
+			fail(w, status)
+			return
+		}
+
+		policyID, err := strconv.Atoi(strings.TrimPrefix(path, "policies/"))
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body conditionBody
+
+		err = json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		id := s.createCondition(policyID, body.Condition)
+
+		respond(w, http.StatusCreated, conditionBody{Condition: *s.conds[id]})
+
+		return
+	}
+
+	id, err := strconv.Atoi(path)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if status, ok := s.record(OperationUpdateCondition); ok {
+			fail(w, status)
+			return
+		}
+
+		if _, ok := s.conds[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body conditionBody
+
+		err = json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		body.Condition.ID = id
+
+		s.conds[id] = &body.Condition
+
+		respond(w, http.StatusOK, body)
+	case http.MethodDelete:
+		if status, ok := s.record(OperationDeleteCondition); ok {
+			fail(w, status)
+			return
+		}
+
+		condition, ok := s.conds[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		delete(s.conds, id)
+		delete(s.policies, id)
+
+		respond(w, http.StatusOK, conditionBody{Condition: *condition})
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
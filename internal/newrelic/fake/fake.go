@@ -0,0 +1,395 @@
+// Package fake provides an in-process stand-in for the New Relic Synthetics REST and NerdGraph APIs,
+// so commands can be exercised end-to-end without a New Relic account.
+package fake
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/newrelic"
+	"github.com/newrelic/newrelic-client-go/pkg/alerts"
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+const (
+	// OperationListMonitors lists monitors.
+	OperationListMonitors = "ListMonitors"
+	// OperationGetMonitor gets a single monitor.
+	OperationGetMonitor = "GetMonitor"
+	// OperationCreateMonitor creates a monitor.
+	OperationCreateMonitor = "CreateMonitor"
+	// OperationUpdateMonitor updates a monitor.
+	OperationUpdateMonitor = "UpdateMonitor"
+	// OperationDeleteMonitor deletes a monitor.
+	OperationDeleteMonitor = "DeleteMonitor"
+	// OperationUpdateMonitorScript updates the script of a monitor.
+	OperationUpdateMonitorScript = "UpdateMonitorScript"
+	// OperationGetMonitorLocations lists the locations which monitors can run from.
+	OperationGetMonitorLocations = "GetMonitorLocations"
+	// OperationListConditions lists the Synthetics alert conditions in a policy.
+	OperationListConditions = "ListSyntheticsConditions"
+	// OperationCreateCondition creates a Synthetics alert condition.
+	OperationCreateCondition = "CreateSyntheticsCondition"
+	// OperationUpdateCondition updates a Synthetics alert condition.
+	OperationUpdateCondition = "UpdateSyntheticsCondition"
+	// OperationDeleteCondition deletes a Synthetics alert condition.
+	OperationDeleteCondition = "DeleteSyntheticsCondition"
+	// OperationEntitySearch searches for entities with NerdGraph.
+	OperationEntitySearch = "entitySearch"
+	// OperationEntities gets entities by GUID with NerdGraph.
+	OperationEntities = "entities"
+	// OperationAddTags adds tags to an entity with NerdGraph.
+	OperationAddTags = "taggingAddTagsToEntity"
+	// OperationReplaceTags replaces the tags on an entity with NerdGraph.
+	OperationReplaceTags = "taggingReplaceTagsOnEntity"
+	// OperationDeleteTags deletes tag keys from an entity with NerdGraph.
+	OperationDeleteTags = "taggingDeleteTagFromEntity"
+	// OperationDeleteTagValues deletes tag values from an entity with NerdGraph.
+	OperationDeleteTagValues = "taggingDeleteTagValuesFromEntity"
+)
+
+// Locations which are returned by the fake.
+var Locations = []string{
+	"AWS_AP_SOUTHEAST_2",
+	"AWS_US_WEST_1",
+	"AWS_EU_WEST_1",
+}
+
+// Server which stores monitors and entity tags in memory.
+type Server struct {
+	*httptest.Server
+
+	// AccountID which entities belong to.
+	AccountID int
+	// PageSize of entity search results, kept small so that cursors are exercised.
+	PageSize int
+	// MonitorPageSize caps the monitors in each page of a listing below the requested limit,
+	// so that short pages part way through a listing are exercised. Zero disables the cap.
+	MonitorPageSize int
+	// IndexingLag is the number of entity queries which omit a monitor after it is created through the API,
+	// as if it had not been indexed yet. Zero indexes monitors straight away.
+	IndexingLag int
+
+	mu       sync.Mutex
+	nextID   int
+	order    []string
+	monitors map[string]*synthetics.Monitor
+	scripts  map[string]string
+	tags     map[string]map[string][]string
+	policies map[int]int
+	conds    map[int]*alerts.SyntheticsCondition
+	nextCond int
+	faults   map[string][]fault
+	late     map[string][]fault
+	requests map[string]int
+
+	unindexed map[string]int
+}
+
+// A fault which is injected into the responses of an operation.
+type fault struct {
+	status int
+	times  int
+}
+
+// New fake which is listening on a local port. The server should be closed once finished with.
+func New(accountID int) *Server {
+	s := &Server{
+		AccountID: accountID,
+		PageSize:  2,
+		monitors:  make(map[string]*synthetics.Monitor),
+		scripts:   make(map[string]string),
+		tags:      make(map[string]map[string][]string),
+		policies:  make(map[int]int),
+		conds:     make(map[int]*alerts.SyntheticsCondition),
+		faults:    make(map[string][]fault),
+		late:      make(map[string][]fault),
+		requests:  make(map[string]int),
+		unindexed: make(map[string]int),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/monitors", s.handleMonitors)
+	mux.HandleFunc("/v4/monitors/", s.handleMonitor)
+	mux.HandleFunc("/v1/locations", s.handleLocations)
+	mux.HandleFunc("/graphql", s.handleGraphQL)
+	mux.HandleFunc("/v2/alerts_synthetics_conditions.json", s.handleConditions)
+	mux.HandleFunc("/v2/alerts_synthetics_conditions/", s.handleCondition)
+
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// Options which point a New Relic client at the fake.
+func (s *Server) Options() []newrelic.ConfigOption {
+	return []newrelic.ConfigOption{
+		newrelic.ConfigPersonalAPIKey("fake"),
+		newrelic.ConfigBaseURL(s.URL + "/v2"),
+		newrelic.ConfigSyntheticsBaseURL(s.URL),
+		newrelic.ConfigNerdGraphBaseURL(s.URL + "/graphql"),
+		newrelic.ConfigLogLevel("error"),
+	}
+}
+
+// Fail the next calls to an operation with a status code.
+// Rate limited responses ask the client to retry straight away.
+func (s *Server) Fail(operation string, status, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults[operation] = append(s.faults[operation], fault{
+		status: status,
+		times:  times,
+	})
+}
+
+// FailLate fails the next calls to an operation with a status code after the change has been made,
+// like a gateway which times out while New Relic is still handling the call. Only creating monitors supports it.
+func (s *Server) FailLate(operation string, status, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.late[operation] = append(s.late[operation], fault{
+		status: status,
+		times:  times,
+	})
+}
+
+// Requests returns the number of calls which have been made to an operation, including failed calls.
+func (s *Server) Requests(operation string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests[operation]
+}
+
+// AddMonitor seeds a monitor along with the tags on its entity, returning the ID of the monitor.
+func (s *Server) AddMonitor(monitor synthetics.Monitor, tags ...entities.Tag) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.create(monitor)
+
+	for _, tag := range tags {
+		s.tags[id][tag.Key] = append(s.tags[id][tag.Key], tag.Values...)
+	}
+
+	return id
+}
+
+// AddTags seeds tags on the entity of an existing monitor.
+func (s *Server) AddTags(id string, tags ...entities.Tag) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, tag := range tags {
+		s.tags[id][tag.Key] = append(s.tags[id][tag.Key], tag.Values...)
+	}
+}
+
+// Monitors which exist, sorted by name.
+func (s *Server) Monitors() []synthetics.Monitor {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := make([]synthetics.Monitor, 0, len(s.monitors))
+
+	for _, monitor := range s.monitors {
+		list = append(list, *monitor)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list
+}
+
+// Monitor returns the monitor with a name.
+func (s *Server) Monitor(name string) (synthetics.Monitor, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, monitor := range s.monitors {
+		if monitor.Name == name {
+			return *monitor, true
+		}
+	}
+
+	return synthetics.Monitor{}, false
+}
+
+// Tags on the entity of a monitor, keyed by tag key.
+func (s *Server) Tags(id string) map[string][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tags := make(map[string][]string, len(s.tags[id]))
+
+	for key, values := range s.tags[id] {
+		tags[key] = append([]string(nil), values...)
+	}
+
+	return tags
+}
+
+// Script of a scripted monitor.
+func (s *Server) Script(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.scripts[id]
+}
+
+// AddCondition seeds a Synthetics alert condition in a policy, returning the ID of the condition.
+func (s *Server) AddCondition(policyID int, condition alerts.SyntheticsCondition) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.createCondition(policyID, condition)
+}
+
+// Conditions in a policy, sorted by ID.
+func (s *Server) Conditions(policyID int) []alerts.SyntheticsCondition {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var list []alerts.SyntheticsCondition
+
+	for id, condition := range s.conds {
+		if s.policies[id] == policyID {
+			list = append(list, *condition)
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+
+	return list
+}
+
+// Index the entity for a monitor straight away, regardless of the indexing lag.
+func (s *Server) Index(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.unindexed, id)
+}
+
+// GUID of the entity for a monitor.
+func (s *Server) GUID(id string) string {
+	return strings.TrimRight(base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%d|SYNTH|MONITOR|%s", s.AccountID, id))), "=")
+}
+
+// Helper function to store a new monitor. The lock must be held.
+func (s *Server) create(monitor synthetics.Monitor) string {
+	s.nextID++
+
+	id := fmt.Sprintf("00000000-0000-0000-0000-%012d", s.nextID)
+
+	monitor.ID = id
+
+	now := synthetics.Time(time.Now())
+
+	if monitor.CreatedAt == nil {
+		monitor.CreatedAt = &now
+	}
+
+	if monitor.ModifiedAt == nil {
+		monitor.ModifiedAt = &now
+	}
+
+	s.monitors[id] = &monitor
+	s.order = append(s.order, id)
+	s.tags[id] = make(map[string][]string)
+
+	return id
+}
+
+// Helper function to store a new condition. The lock must be held.
+func (s *Server) createCondition(policyID int, condition alerts.SyntheticsCondition) int {
+	s.nextCond++
+
+	condition.ID = s.nextCond
+
+	s.conds[condition.ID] = &condition
+	s.policies[condition.ID] = policyID
+
+	return condition.ID
+}
+
+// Helper function to check if the entity for a monitor has been indexed. The lock must be held.
+func (s *Server) indexed(id string) bool {
+	return s.unindexed[id] == 0
+}
+
+// Helper function to count down the indexing lag of monitors after an entity query. The lock must be held.
+func (s *Server) index() {
+	for id := range s.unindexed {
+		s.unindexed[id]--
+
+		if s.unindexed[id] <= 0 {
+			delete(s.unindexed, id)
+		}
+	}
+}
+
+// Helper function to delete a monitor. The lock must be held.
+func (s *Server) delete(id string) {
+	delete(s.monitors, id)
+	delete(s.scripts, id)
+	delete(s.tags, id)
+
+	for i, existing := range s.order {
+		if existing == id {
+			s.order = append(s.order[:i], s.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// Helper function to record a request and return an injected fault, if any. The lock must be held.
+func (s *Server) record(operation string) (int, bool) {
+	s.requests[operation]++
+
+	return next(s.faults, operation)
+}
+
+// Helper function to return the next fault for an operation, if any. The lock must be held.
+func next(faults map[string][]fault, operation string) (int, bool) {
+	queue := faults[operation]
+	if len(queue) == 0 {
+		return 0, false
+	}
+
+	status := queue[0].status
+
+	queue[0].times--
+	if queue[0].times <= 0 {
+		queue = queue[1:]
+	}
+
+	faults[operation] = queue
+
+	return status, true
+}
+
+// Helper function to respond with an injected fault.
+func fail(w http.ResponseWriter, status int) {
+	if status == http.StatusTooManyRequests {
+		w.Header().Set("Retry-After", "0")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"error":{"title":"injected fault %d"}}`, status)
+}
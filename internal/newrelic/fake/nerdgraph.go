@@ -0,0 +1,354 @@
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+)
+
+// Used to parse the entity search queries which are sent by this tool.
+var (
+	accountRegex = regexp.MustCompile(`accountId = (\d+)`)
+	tagRegex     = regexp.MustCompile(`tags\.(\w+) = '((?:[^'\\]|\\.)*)'`)
+	namesRegex   = regexp.MustCompile(`name IN \((.*)\)`)
+	quotedRegex  = regexp.MustCompile(`'((?:[^'\\]|\\.)*)'`)
+)
+
+type graphQLRequest struct {
+	Query     string          `json:"query"`
+	Variables json.RawMessage `json:"variables"`
+}
+
+// An entity as returned by NerdGraph.
+type entity struct {
+	AccountID int            `json:"accountId"`
+	GUID      string         `json:"guid"`
+	Name      string         `json:"name"`
+	Permalink string         `json:"permalink"`
+	Tags      []entities.Tag `json:"tags"`
+}
+
+// Helper function to handle NerdGraph queries and mutations.
+func (s *Server) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req graphQLRequest
+
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	operation := graphQLOperation(req.Query)
+
+	if status, ok := s.record(operation); ok {
+		fail(w, status)
+		return
+	}
+
+	var data interface{}
+
+	switch operation {
+	case OperationEntitySearch:
+		data, err = s.entitySearch(req.Variables)
+	case OperationEntities:
+		data, err = s.entities(req.Variables)
+	case OperationAddTags, OperationReplaceTags, OperationDeleteTags, OperationDeleteTagValues:
+		data, err = s.tagging(operation, req.Variables)
+	default:
+		http.Error(w, "unsupported query", http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	respond(w, http.StatusOK, map[string]interface{}{
+		"data": data,
+	})
+}
+
+// Helper function to determine which operation a query is for.
+func graphQLOperation(query string) string {
+	for _, operation := range []string{OperationEntitySearch, OperationAddTags, OperationReplaceTags, OperationDeleteTags, OperationDeleteTagValues} {
+		if strings.Contains(query, operation+"(") {
+			return operation
+		}
+	}
+
+	if strings.Contains(query, "entities(guids") {
+		return OperationEntities
+	}
+
+	return ""
+}
+
+// Helper function to search for monitor entities, paginated with a cursor.
+func (s *Server) entitySearch(raw json.RawMessage) (interface{}, error) {
+	var vars struct {
+		Query  string  `json:"query"`
+		Cursor *string `json:"cursor"`
+	}
+
+	err := json.Unmarshal(raw, &vars)
+	if err != nil {
+		return nil, err
+	}
+
+	defer s.index()
+
+	var matched []entity
+
+	for _, id := range s.order {
+		if !s.indexed(id) {
+			continue
+		}
+
+		e := s.entity(id)
+
+		if s.matches(vars.Query, e) {
+			matched = append(matched, e)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].Name < matched[j].Name
+	})
+
+	offset := 0
+
+	if vars.Cursor != nil && *vars.Cursor != "" {
+		offset, err = strconv.Atoi(*vars.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	end := offset + s.PageSize
+	if end > len(matched) {
+		end = len(matched)
+	}
+
+	var next *string
+
+	if end < len(matched) {
+		cursor := strconv.Itoa(end)
+		next = &cursor
+	}
+
+	page := []entity{}
+	if offset < len(matched) {
+		page = matched[offset:end]
+	}
+
+	return map[string]interface{}{
+		"actor": map[string]interface{}{
+			"entitySearch": map[string]interface{}{
+				"results": map[string]interface{}{
+					"nextCursor": next,
+					"entities":   page,
+				},
+			},
+		},
+	}, nil
+}
+
+// Helper function to get entities by GUID.
+func (s *Server) entities(raw json.RawMessage) (interface{}, error) {
+	var vars struct {
+		GUIDs []string `json:"guids"`
+	}
+
+	err := json.Unmarshal(raw, &vars)
+	if err != nil {
+		return nil, err
+	}
+
+	defer s.index()
+
+	list := []entity{}
+
+	for _, guid := range vars.GUIDs {
+		for _, id := range s.order {
+			if s.GUID(id) == guid && s.indexed(id) {
+				list = append(list, s.entity(id))
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"actor": map[string]interface{}{
+			"entities": list,
+		},
+	}, nil
+}
+
+// Helper function to apply a tagging mutation.
+func (s *Server) tagging(operation string, raw json.RawMessage) (interface{}, error) {
+	var vars struct {
+		GUID      string              `json:"guid"`
+		Tags      []entities.Tag      `json:"tags"`
+		TagKeys   []string            `json:"tagKeys"`
+		TagValues []entities.TagValue `json:"tagValues"`
+	}
+
+	err := json.Unmarshal(raw, &vars)
+	if err != nil {
+		return nil, err
+	}
+
+	var errors []map[string]string
+
+	id := s.monitorID(vars.GUID)
+	if id == "" {
+		errors = append(errors, map[string]string{
+			"type":    "NOT_FOUND",
+			"message": "entity not found: " + vars.GUID,
+		})
+	}
+
+	tags := s.tags[id]
+
+	switch {
+	case id == "":
+	case operation == OperationReplaceTags:
+		tags = make(map[string][]string)
+		fallthrough
+	case operation == OperationAddTags:
+		for _, tag := range vars.Tags {
+			for _, value := range tag.Values {
+				if !contains(tags[tag.Key], value) {
+					tags[tag.Key] = append(tags[tag.Key], value)
+				}
+			}
+		}
+	case operation == OperationDeleteTags:
+		for _, key := range vars.TagKeys {
+			delete(tags, key)
+		}
+	case operation == OperationDeleteTagValues:
+		for _, tv := range vars.TagValues {
+			var values []string
+
+			for _, value := range tags[tv.Key] {
+				if value != tv.Value {
+					values = append(values, value)
+				}
+			}
+
+			if len(values) == 0 {
+				delete(tags, tv.Key)
+			} else {
+				tags[tv.Key] = values
+			}
+		}
+	}
+
+	if id != "" {
+		s.tags[id] = tags
+	}
+
+	return map[string]interface{}{
+		operation: map[string]interface{}{
+			"errors": errors,
+		},
+	}, nil
+}
+
+// Helper function to build the entity for a monitor. The lock must be held.
+func (s *Server) entity(id string) entity {
+	guid := s.GUID(id)
+
+	e := entity{
+		AccountID: s.AccountID,
+		GUID:      guid,
+		Name:      s.monitors[id].Name,
+		Permalink: "https://one.newrelic.com/redirect/entity/" + guid,
+		Tags:      []entities.Tag{},
+	}
+
+	keys := make([]string, 0, len(s.tags[id]))
+	for key := range s.tags[id] {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		e.Tags = append(e.Tags, entities.Tag{
+			Key:    key,
+			Values: append([]string(nil), s.tags[id][key]...),
+		})
+	}
+
+	return e
+}
+
+// Helper function to find the monitor for an entity GUID which has been indexed. The lock must be held.
+func (s *Server) monitorID(guid string) string {
+	for _, id := range s.order {
+		if s.GUID(id) == guid && s.indexed(id) {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// Helper function to check if an entity matches a search query.
+func (s *Server) matches(query string, e entity) bool {
+	if m := accountRegex.FindStringSubmatch(query); m != nil && m[1] != strconv.Itoa(e.AccountID) {
+		return false
+	}
+
+	for _, m := range tagRegex.FindAllStringSubmatch(query, -1) {
+		found := false
+
+		for _, tag := range e.Tags {
+			if tag.Key == m[1] && contains(tag.Values, unescape(m[2])) {
+				found = true
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if m := namesRegex.FindStringSubmatch(query); m != nil {
+		var names []string
+
+		for _, quoted := range quotedRegex.FindAllStringSubmatch(m[1], -1) {
+			names = append(names, unescape(quoted[1]))
+		}
+
+		return contains(names, e.Name)
+	}
+
+	return true
+}
+
+// Helper function to reverse the escaping applied to values in a search query.
+func unescape(value string) string {
+	return strings.NewReplacer(`\'`, `'`, `\\`, `\`).Replace(value)
+}
+
+// Helper function to check if a list contains a value.
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,261 @@
+package fake
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+// Helper function to handle listing and creating monitors.
+func (s *Server) handleMonitors(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.listMonitors(w, r)
+	case http.MethodPost:
+		s.createMonitor(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// Helper function to handle a single monitor or its script.
+func (s *Server) handleMonitor(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v4/monitors/"), "/"), "/")
+
+	id := parts[0]
+
+	if len(parts) == 2 && parts[1] == "script" && r.Method == http.MethodPut {
+		s.updateScript(w, r, id)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.getMonitor(w, id)
+	case http.MethodPut:
+		s.updateMonitor(w, r, id)
+	case http.MethodDelete:
+		s.deleteMonitor(w, id)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// Helper function to list monitors in the order they were created, using offset pagination.
+func (s *Server) listMonitors(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status, ok := s.record(OperationListMonitors); ok {
+		fail(w, status)
+		return
+	}
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+	if limit <= 0 {
+		limit = 50
+	}
+
+	if s.MonitorPageSize > 0 && s.MonitorPageSize < limit {
+		limit = s.MonitorPageSize
+	}
+
+	resp := synthetics.ListMonitorsResponse{
+		Monitors: []*synthetics.Monitor{},
+	}
+
+	for i := offset; i < len(s.order) && i < offset+limit; i++ {
+		monitor := *s.monitors[s.order[i]]
+		resp.Monitors = append(resp.Monitors, &monitor)
+	}
+
+	// The count is the total number of monitors in the account, not the number in this page.
+	resp.Count = len(s.order)
+
+	respond(w, http.StatusOK, resp)
+}
+
+// Helper function to get a single monitor.
+func (s *Server) getMonitor(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status, ok := s.record(OperationGetMonitor); ok {
+		fail(w, status)
+		return
+	}
+
+	monitor, ok := s.monitors[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	respond(w, http.StatusOK, monitor)
+}
+
+// Helper function to create a monitor, returning its location like the real API.
+func (s *Server) createMonitor(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status, ok := s.record(OperationCreateMonitor); ok {
+		fail(w, status)
+		return
+	}
+
+	var monitor synthetics.Monitor
+
+	err := json.NewDecoder(r.Body).Decode(&monitor)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, existing := range s.monitors {
+		if existing.Name == monitor.Name {
+			http.Error(w, `{"error":{"title":"monitor name already exists"}}`, http.StatusBadRequest)
+			return
+		}
+	}
+
+	id := s.create(monitor)
+
+	if s.IndexingLag > 0 {
+		s.unindexed[id] = s.IndexingLag
+	}
+
+	if status, ok := next(s.late, OperationCreateMonitor); ok {
+		fail(w, status)
+		return
+	}
+
+	w.Header().Set("Location", s.URL+"/v4/monitors/"+id)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Helper function to update a monitor.
+func (s *Server) updateMonitor(w http.ResponseWriter, r *http.Request, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status, ok := s.record(OperationUpdateMonitor); ok {
+		fail(w, status)
+		return
+	}
+
+	existing, ok := s.monitors[id]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	var monitor synthetics.Monitor
+
+	err := json.NewDecoder(r.Body).Decode(&monitor)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	now := synthetics.Time(time.Now())
+
+	monitor.ID = id
+	monitor.CreatedAt = existing.CreatedAt
+	monitor.ModifiedAt = &now
+
+	s.monitors[id] = &monitor
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to delete a monitor along with its entity.
+func (s *Server) deleteMonitor(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status, ok := s.record(OperationDeleteMonitor); ok {
+		fail(w, status)
+		return
+	}
+
+	if _, ok := s.monitors[id]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	s.delete(id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to update the script of a monitor.
+func (s *Server) updateScript(w http.ResponseWriter, r *http.Request, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status, ok := s.record(OperationUpdateMonitorScript); ok {
+		fail(w, status)
+		return
+	}
+
+	if _, ok := s.monitors[id]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	var script synthetics.MonitorScript
+
+	err := json.NewDecoder(r.Body).Decode(&script)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	text, err := base64.StdEncoding.DecodeString(script.Text)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.scripts[id] = string(text)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Helper function to list the locations which monitors can run from.
+func (s *Server) handleLocations(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status, ok := s.record(OperationGetMonitorLocations); ok {
+		fail(w, status)
+		return
+	}
+
+	list := make([]*synthetics.MonitorLocation, len(Locations))
+
+	for i, name := range Locations {
+		list[i] = &synthetics.MonitorLocation{
+			Name:  name,
+			Label: name,
+		}
+	}
+
+	respond(w, http.StatusOK, list)
+}
+
+// Helper function to write a JSON response.
+func respond(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,321 @@
+// Package inventory records the alert conditions which were created alongside a monitor, so they can be
+// torn down with it. The inventory is stored on the monitor itself as values of the inventory tag.
+// Other objects eg. dashboards or workflows are not created by this tool, so they are not recorded or deleted.
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	nrerrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	entityutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/entity"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/report"
+)
+
+// Kind of object which was created alongside a monitor.
+type Kind string
+
+const (
+	// KindAlertCondition is a Synthetics alert condition for the monitor.
+	KindAlertCondition Kind = "alertCondition"
+)
+
+// Order which kinds are deleted in. Objects which depend on other objects are deleted first,
+// the monitor itself is always deleted last.
+var Order = []Kind{
+	KindAlertCondition,
+}
+
+// Item which was created alongside a monitor.
+type Item struct {
+	Kind Kind
+	ID   string
+}
+
+// String representation of an item, as stored in the inventory tag.
+func (i Item) String() string {
+	return fmt.Sprintf("%s:%s", i.Kind, i.ID)
+}
+
+// AlertCondition item for a Synthetics alert condition in a policy.
+func AlertCondition(policyID, conditionID int) Item {
+	return Item{
+		Kind: KindAlertCondition,
+		ID:   fmt.Sprintf("%d/%d", policyID, conditionID),
+	}
+}
+
+// AlertCondition returns the policy and condition IDs of an alert condition item.
+// The policy ID is zero for items which were recorded before the policy was included.
+func (i Item) AlertCondition() (int, int, error) {
+	if i.Kind != KindAlertCondition {
+		return 0, 0, fmt.Errorf("inventory item is not an alert condition: %s", i)
+	}
+
+	var (
+		policyID int
+		value    = i.ID
+	)
+
+	if parts := strings.SplitN(i.ID, "/", 2); len(parts) == 2 {
+		id, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid alert policy ID: %s", i.ID)
+		}
+
+		policyID, value = id, parts[1]
+	}
+
+	conditionID, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid alert condition ID: %s", i.ID)
+	}
+
+	return policyID, conditionID, nil
+}
+
+// Parse an item from the inventory tag.
+func Parse(value string) (Item, error) {
+	parts := strings.SplitN(value, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return Item{}, fmt.Errorf("inventory item must be in the form kind:id: %s", value)
+	}
+
+	item := Item{
+		Kind: Kind(parts[0]),
+		ID:   parts[1],
+	}
+
+	if rank(item.Kind) < 0 {
+		return Item{}, fmt.Errorf("unknown inventory kind: %s", item.Kind)
+	}
+
+	return item, nil
+}
+
+// FromTags returns the items recorded on a monitor, in the order they should be deleted.
+// Items which can't be parsed are logged and ignored.
+func FromTags(tags []*entities.Tag) []Item {
+	var items []Item
+
+	for _, tag := range tags {
+		if tag == nil || tag.Key != entityutils.TagInventory {
+			continue
+		}
+
+		for _, value := range tag.Values {
+			item, err := Parse(value)
+			if err != nil {
+				log.Warnln("Ignoring inventory item:", err)
+				continue
+			}
+
+			items = append(items, item)
+		}
+	}
+
+	Sort(items)
+
+	return items
+}
+
+// Tags which record items on a monitor.
+func Tags(items []Item) []entities.Tag {
+	if len(items) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(items))
+
+	for i, item := range items {
+		values[i] = item.String()
+	}
+
+	return []entities.Tag{
+		{
+			Key:    entityutils.TagInventory,
+			Values: values,
+		},
+	}
+}
+
+// Sort items into the order they should be deleted.
+func Sort(items []Item) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if rank(items[i].Kind) != rank(items[j].Kind) {
+			return rank(items[i].Kind) < rank(items[j].Kind)
+		}
+
+		return items[i].ID < items[j].ID
+	})
+}
+
+// Stale items which are recorded but no longer desired.
+func Stale(current, desired []Item) []Item {
+	want := make(map[Item]bool, len(desired))
+
+	for _, item := range desired {
+		want[item] = true
+	}
+
+	var stale []Item
+
+	for _, item := range current {
+		if !want[item] {
+			stale = append(stale, item)
+		}
+	}
+
+	return stale
+}
+
+// Merge the items which are recorded with the desired items, without deleting anything.
+func Merge(current, desired []Item) []Item {
+	items := append([]Item{}, desired...)
+
+	items = append(items, Stale(current, desired)...)
+
+	Sort(items)
+
+	return items
+}
+
+// Prune deletes the items recorded on a monitor which are no longer desired, returning the items which should
+// now be recorded. Items which could not be deleted are kept so that they are retried on the next run.
+func Prune(client *api.Client, tags []*entities.Tag, desired []Item) []Item {
+	items := append([]Item{}, desired...)
+
+	for _, item := range Stale(FromTags(tags), desired) {
+		logger := log.WithField("item", item.String())
+
+		logger.Infoln("Deleting object which is no longer required")
+
+		err := Delete(client, item)
+		if err != nil {
+			logger.Warnln("Failed to delete object which is no longer required:", err)
+			items = append(items, item)
+		}
+	}
+
+	Sort(items)
+
+	return items
+}
+
+// Delete an item. Items which no longer exist are treated as deleted.
+func Delete(client *api.Client, item Item) error {
+	var err error
+
+	switch item.Kind {
+	case KindAlertCondition:
+		_, id, convErr := item.AlertCondition()
+		if convErr != nil {
+			return convErr
+		}
+
+		err = client.Call("Alerts.DeleteSyntheticsCondition", func() error {
+			_, err := client.Alerts.DeleteSyntheticsCondition(id)
+			return err
+		})
+	default:
+		return fmt.Errorf("unknown inventory kind: %s", item.Kind)
+	}
+
+	var notFound *nrerrors.NotFound
+	if errors.As(err, &notFound) {
+		return nil
+	}
+
+	return err
+}
+
+// Cascade deletes the alert conditions recorded on a monitor, followed by the monitor itself.
+// Each deletion is added to the report alongside the monitor's result. The monitor is only deleted once
+// every item has been, so items which failed stay recorded on the monitor and are retried on the next run.
+func Cascade(client *api.Client, rpt *report.Report, result *report.Result, tags []*entities.Tag, dryRun bool) {
+	logger := log.WithFields(log.Fields{
+		"name": result.Monitor,
+	})
+
+	var failed int
+
+	for _, item := range FromTags(tags) {
+		item := item
+
+		child := &report.Result{
+			Namespace: result.Namespace,
+			Route:     result.Route,
+			URL:       result.URL,
+			Monitor:   result.Monitor,
+			MonitorID: result.MonitorID,
+			GUID:      result.GUID,
+			Object:    item.String(),
+		}
+
+		rpt.Add(child)
+
+		if dryRun {
+			logger.Infoln("Dry run is enabled. The following would have been deleted:", item)
+			child.Action = report.ActionDryRun
+			continue
+		}
+
+		logger.Infoln("Deleting", item)
+
+		err := Delete(client, item)
+		if err != nil {
+			logger.Errorf("Failed to delete %s: %s", item, err)
+			child.Action = report.ActionFailed
+			child.Error = fmt.Errorf("failed to delete %s: %w", item, err)
+			failed++
+			continue
+		}
+
+		child.Action = report.ActionDeleted
+	}
+
+	if dryRun {
+		logger.Infoln("Dry run is enabled. A monitor would have been deleted.")
+		result.Action = report.ActionDryRun
+		result.Reason = "monitor would have been deleted"
+		return
+	}
+
+	if failed > 0 {
+		result.Action = report.ActionFailed
+		result.Error = fmt.Errorf("monitor was not deleted because %d alert conditions could not be deleted", failed)
+		return
+	}
+
+	logger.Infoln("Deleting monitor")
+
+	err := client.Call("Synthetics.DeleteMonitor", func() error {
+		return client.Synthetics.DeleteMonitor(result.MonitorID)
+	})
+	if err != nil {
+		logger.Errorln("Failed to delete monitor:", err)
+		result.Action = report.ActionFailed
+		result.Error = fmt.Errorf("failed to delete monitor: %w", err)
+		return
+	}
+
+	result.Action = report.ActionDeleted
+}
+
+// Helper function to rank a kind by the order it is deleted in.
+func rank(kind Kind) int {
+	for i, k := range Order {
+		if k == kind {
+			return i
+		}
+	}
+
+	return -1
+}
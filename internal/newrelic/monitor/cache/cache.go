@@ -0,0 +1,337 @@
+// Package cache persists the list of monitors between runs, so frequent runs only fetch the monitors which
+// were created since the last run instead of listing the whole account.
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	nrerrors "github.com/newrelic/newrelic-client-go/pkg/errors"
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/alecthomas/kingpin.v2"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/metrics"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/state"
+)
+
+const (
+	// KindFull is a listing of every monitor in the account.
+	KindFull = "full"
+	// KindIncremental is a listing of the monitors which were created since the cached listing.
+	KindIncremental = "incremental"
+
+	// ConfigMapKey which the cache is stored under.
+	ConfigMapKey = "monitors.json.gz"
+)
+
+// Options which configure where the cache is stored and how often every monitor is listed again.
+type Options struct {
+	File string
+	// ConfigMap which the cache is stored in, in the form "namespace/name".
+	ConfigMap    string
+	FullInterval time.Duration
+}
+
+// Flags which configure the cache for a command.
+func (o *Options) Flags(command *kingpin.CmdClause) {
+	command.Flag("monitor-cache-file", "File which the list of monitors is cached in between runs").Envar("MONITOR_CACHE_FILE").StringVar(&o.File)
+	command.Flag("monitor-cache-configmap", "ConfigMap which the list of monitors is cached in between runs eg. namespace/name").Envar("MONITOR_CACHE_CONFIGMAP").StringVar(&o.ConfigMap)
+	command.Flag("monitor-cache-full-interval", "How often every monitor is listed again. In between, monitors which were edited outside of this tool are only picked up when they are synced, which reads them again").Envar("MONITOR_CACHE_FULL_INTERVAL").Default("1h").DurationVar(&o.FullInterval)
+}
+
+// State which is persisted between runs.
+type State struct {
+	// Account which the monitors belong to.
+	Account int `json:"account"`
+	// ListedAt is when every monitor was last listed.
+	ListedAt time.Time `json:"listedAt"`
+	// RefreshedAt is when the monitors were last listed, either in full or incrementally.
+	RefreshedAt time.Time `json:"refreshedAt"`
+	// Monitors in the order they are listed by the API, which is the order they were created.
+	Monitors []*synthetics.Monitor `json:"monitors"`
+}
+
+// Cache of the monitors in an account. A nil cache is valid and always lists every monitor.
+type Cache struct {
+	store        state.Store
+	account      int
+	fullInterval time.Duration
+
+	mu    sync.Mutex
+	state State
+	// Monitors which were listed by the API during this run, rather than loaded from the cache.
+	listed map[string]bool
+}
+
+// New cache for an account, or nil when caching is disabled.
+// The client is only used when the cache is stored in a ConfigMap.
+func New(opts Options, client coreclient.ConfigMapsGetter, account int) (*Cache, error) {
+	if opts.File != "" && opts.ConfigMap != "" {
+		return nil, fmt.Errorf("the monitor cache can be stored in a file or a ConfigMap, not both")
+	}
+
+	c := &Cache{
+		account:      account,
+		fullInterval: opts.FullInterval,
+	}
+
+	switch {
+	case opts.File != "":
+		c.store = state.File(opts.File)
+	case opts.ConfigMap != "":
+		if client == nil {
+			return nil, fmt.Errorf("the monitor cache can't be stored in a ConfigMap without a cluster")
+		}
+
+		store, err := state.ConfigMap(client, opts.ConfigMap, ConfigMapKey)
+		if err != nil {
+			return nil, err
+		}
+
+		c.store = store
+	default:
+		return nil, nil
+	}
+
+	return c, nil
+}
+
+// List the monitors in the account. Every monitor is listed when the cache is empty, belongs to another account,
+// is older than the full interval or no longer lines up with the listing. Otherwise only the last page of cached
+// monitors is listed again, followed by any monitors which were created since. A monitor which was deleted shifts
+// the rest of the listing, so it is noticed when the last page no longer matches the cache.
+func (c *Cache) List(client *api.Client, now time.Time) ([]*synthetics.Monitor, error) {
+	if c == nil {
+		metrics.MonitorListings.WithLabelValues(KindFull).Inc()
+		return monitorutils.List(client)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	logger := log.WithField("cache", c.store)
+
+	var cached State
+
+	ok, err := c.store.Load(&cached)
+	if err != nil {
+		logger.Warnln("Listing every monitor because the cache could not be loaded:", err)
+	}
+
+	if ok && err == nil && c.fresh(cached, now) {
+		monitors, listed, ok, err := refresh(client, cached.Monitors)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			logger.WithFields(log.Fields{
+				"cached":  len(cached.Monitors),
+				"created": len(monitors) - len(cached.Monitors),
+			}).Infoln("Refreshed cached monitors")
+
+			metrics.MonitorListings.WithLabelValues(KindIncremental).Inc()
+
+			cached.Monitors = monitors
+			cached.RefreshedAt = now
+			c.state = cached
+			c.listed = ids(monitors[len(monitors)-listed:])
+
+			return append([]*synthetics.Monitor{}, monitors...), nil
+		}
+
+		logger.Infoln("Listing every monitor because the cache no longer matches the listing")
+	}
+
+	monitors, err := monitorutils.List(client)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.MonitorListings.WithLabelValues(KindFull).Inc()
+
+	c.state = State{
+		Account:     c.account,
+		ListedAt:    now,
+		RefreshedAt: now,
+		Monitors:    monitors,
+	}
+	c.listed = ids(monitors)
+
+	return append([]*synthetics.Monitor{}, monitors...), nil
+}
+
+// Put a monitor which was created or updated by this run, so the next run doesn't need to list it again.
+// Monitors which were created are appended, because that is where the API lists them.
+func (c *Cache) Put(monitor *synthetics.Monitor) {
+	if c == nil || monitor == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m := *monitor
+
+	// The API doesn't return the monitor after an update, so the time of the write is recorded instead.
+	modified := synthetics.Time(time.Now())
+	m.ModifiedAt = &modified
+
+	c.replace(&m)
+}
+
+// Verify the monitors with the given names by reading them again, unless they were listed by the API during this run.
+// An incremental listing only covers the last page, so a monitor on an earlier page may have been edited since it was
+// cached, and comparing or merging it with stale fields would revert those edits. A monitor which no longer exists is
+// dropped, and every monitor is listed again on the next run. The monitors are returned with the verified copies.
+func (c *Cache) Verify(client *api.Client, monitors []*synthetics.Monitor, names map[string]bool) ([]*synthetics.Monitor, error) {
+	if c == nil {
+		return monitors, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	verified := make([]*synthetics.Monitor, 0, len(monitors))
+
+	for _, monitor := range monitors {
+		if !names[monitor.Name] || c.listed[monitor.ID] {
+			verified = append(verified, monitor)
+			continue
+		}
+
+		m, err := monitorutils.Get(client, monitor.ID)
+
+		var notFound *nrerrors.NotFound
+		if errors.As(err, &notFound) {
+			log.WithField("id", monitor.ID).Infoln("Cached monitor no longer exists, every monitor will be listed on the next run")
+			c.remove(monitor.ID)
+			c.state.ListedAt = time.Time{}
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		c.listed[m.ID] = true
+		c.replace(m)
+
+		verified = append(verified, m)
+	}
+
+	return verified, nil
+}
+
+// Save the cache so the next run can use it.
+func (c *Cache) Save() error {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.listed == nil {
+		return nil
+	}
+
+	return c.store.Save(c.state)
+}
+
+// Helper function to replace a cached monitor, appending it if it isn't cached yet. The lock must be held.
+func (c *Cache) replace(monitor *synthetics.Monitor) {
+	for i, existing := range c.state.Monitors {
+		if existing.ID == monitor.ID {
+			c.state.Monitors[i] = monitor
+			return
+		}
+	}
+
+	c.state.Monitors = append(c.state.Monitors, monitor)
+}
+
+// Helper function to remove a cached monitor. The lock must be held.
+func (c *Cache) remove(id string) {
+	for i, existing := range c.state.Monitors {
+		if existing.ID == id {
+			c.state.Monitors = append(c.state.Monitors[:i:i], c.state.Monitors[i+1:]...)
+			return
+		}
+	}
+}
+
+// Helper function to collect the IDs of monitors.
+func ids(monitors []*synthetics.Monitor) map[string]bool {
+	set := make(map[string]bool, len(monitors))
+
+	for _, monitor := range monitors {
+		set[monitor.ID] = true
+	}
+
+	return set
+}
+
+// Helper function to check if a cached listing can be refreshed instead of listing every monitor again.
+func (c *Cache) fresh(cached State, now time.Time) bool {
+	if cached.Account != c.account {
+		return false
+	}
+
+	return now.Sub(cached.ListedAt) < c.fullInterval
+}
+
+// Helper function to list the last page of cached monitors along with the monitors created since, returning how many
+// monitors at the end of the listing were listed by the API. The listing is only used when the last page still lines up with the cache.
+func refresh(client *api.Client, cached []*synthetics.Monitor) ([]*synthetics.Monitor, int, bool, error) {
+	offset := len(cached) - monitorutils.PageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	listed, _, err := monitorutils.ListFrom(client, offset)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	overlap := cached[offset:]
+
+	if len(listed) < len(overlap) {
+		return nil, 0, false, nil
+	}
+
+	monitors := append([]*synthetics.Monitor{}, cached[:offset]...)
+
+	for i, monitor := range listed {
+		if i < len(overlap) {
+			if monitor.ID != overlap[i].ID {
+				return nil, 0, false, nil
+			}
+
+			monitor = newer(overlap[i], monitor)
+		}
+
+		monitors = append(monitors, monitor)
+	}
+
+	return monitors, len(listed), true, nil
+}
+
+// Helper function to pick the monitor which was modified most recently, preferring the listed monitor.
+func newer(cached, listed *synthetics.Monitor) *synthetics.Monitor {
+	if cached.ModifiedAt == nil || listed.ModifiedAt == nil {
+		return listed
+	}
+
+	if time.Time(*cached.ModifiedAt).After(time.Time(*listed.ModifiedAt)) {
+		return cached
+	}
+
+	return listed
+}
@@ -0,0 +1,390 @@
+package cache
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	kubefake "github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/fake"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/fake"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+)
+
+func newClient(t *testing.T, server *fake.Server) *api.Client {
+	client, err := api.New(api.Limits{Retries: 2, Backoff: time.Millisecond}, server.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func seed(server *fake.Server, from, count int) {
+	for i := from; i < from+count; i++ {
+		server.AddMonitor(synthetics.Monitor{
+			Name: fmt.Sprintf("https://%03d.example.com", i),
+			Type: monitorutils.DefaultType,
+		})
+	}
+}
+
+func newCache(t *testing.T, opts Options) *Cache {
+	if opts.FullInterval == 0 {
+		opts.FullInterval = time.Hour
+	}
+
+	c, err := New(opts, kubefake.New().Core, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+// Helper function to list monitors with a cache and save it, returning the number of list requests which were made.
+func list(t *testing.T, c *Cache, server *fake.Server, now time.Time, expected int) int {
+	before := server.Requests(fake.OperationListMonitors)
+
+	monitors, err := c.List(newClient(t, server), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(monitors) != expected {
+		t.Errorf("expected %d monitors, got %d", expected, len(monitors))
+	}
+
+	seen := make(map[string]bool, len(monitors))
+
+	for _, monitor := range monitors {
+		if seen[monitor.ID] {
+			t.Errorf("monitor %s was listed twice", monitor.ID)
+		}
+
+		seen[monitor.ID] = true
+	}
+
+	err = c.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return server.Requests(fake.OperationListMonitors) - before
+}
+
+func TestListIncremental(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	seed(server, 0, 200)
+
+	kube := kubefake.New()
+
+	c, err := New(Options{ConfigMap: "default/monitors", FullInterval: time.Hour}, kube.Core, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+
+	if requests := list(t, c, server, now, 200); requests != 4 {
+		t.Errorf("expected every monitor to be listed with 4 requests, got %d", requests)
+	}
+
+	seed(server, 200, 3)
+
+	// The cache is loaded from the ConfigMap, as it would be by the next run.
+	c, err = New(Options{ConfigMap: "default/monitors", FullInterval: time.Hour}, kube.Core, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := list(t, c, server, now.Add(time.Minute), 203); requests != 2 {
+		t.Errorf("expected the last page and the new monitors to be listed with 2 requests, got %d", requests)
+	}
+}
+
+func TestListDetectsDeletions(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	seed(server, 0, 120)
+
+	c := newCache(t, Options{ConfigMap: "default/monitors"})
+
+	now := time.Now()
+
+	list(t, c, server, now, 120)
+
+	client := newClient(t, server)
+
+	first, _ := server.Monitor("https://000.example.com")
+
+	err := client.Synthetics.DeleteMonitor(first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The deletion shifts the listing, so the last page no longer lines up and every monitor is listed again.
+	if requests := list(t, c, server, now.Add(time.Minute), 119); requests != 1+3 {
+		t.Errorf("expected the last page followed by every monitor to be listed, got %d requests", requests)
+	}
+}
+
+func TestListFullInterval(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	seed(server, 0, 120)
+
+	c := newCache(t, Options{ConfigMap: "default/monitors", FullInterval: time.Hour})
+
+	now := time.Now()
+
+	list(t, c, server, now, 120)
+
+	if requests := list(t, c, server, now.Add(30*time.Minute), 120); requests != 1 {
+		t.Errorf("expected the last page to be listed, got %d requests", requests)
+	}
+
+	// Refreshing the cache doesn't move the full listing, so it still happens on the interval.
+	if requests := list(t, c, server, now.Add(time.Hour), 120); requests != 3 {
+		t.Errorf("expected every monitor to be listed, got %d requests", requests)
+	}
+}
+
+func TestListOtherAccount(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	seed(server, 0, 10)
+
+	kube := kubefake.New()
+
+	c, err := New(Options{ConfigMap: "default/monitors", FullInterval: time.Hour}, kube.Core, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list(t, c, server, time.Now(), 10)
+
+	c, err = New(Options{ConfigMap: "default/monitors", FullInterval: time.Hour}, kube.Core, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.List(newClient(t, server), time.Now())
+
+	if c.state.ListedAt.IsZero() || c.state.Account != 2 {
+		t.Errorf("expected every monitor to be listed for the other account, got %+v", c.state)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	seed(server, 0, 120)
+
+	client := newClient(t, server)
+
+	c := newCache(t, Options{ConfigMap: "default/monitors"})
+
+	now := time.Now()
+
+	list(t, c, server, now, 120)
+
+	// The first monitor is edited outside of this tool, where an incremental listing won't see it.
+	first, _ := server.Monitor("https://000.example.com")
+	first.Frequency = 60
+
+	_, err := client.Synthetics.UpdateMonitor(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitors, err := c.List(client, now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if monitors[0].Frequency == 60 {
+		t.Fatal("expected the incremental listing to miss the edit")
+	}
+
+	names := map[string]bool{
+		"https://000.example.com": true,
+		"https://119.example.com": true,
+	}
+
+	monitors, err = c.Verify(client, monitors, names)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if monitors[0].Frequency != 60 {
+		t.Errorf("expected the edit to be picked up, got frequency %d", monitors[0].Frequency)
+	}
+
+	// The last page was listed by this run, so it doesn't need to be read again.
+	if requests := server.Requests(fake.OperationGetMonitor); requests != 1 {
+		t.Errorf("expected 1 monitor to be read again, got %d requests", requests)
+	}
+
+	err = c.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c = newCache(t, Options{ConfigMap: "default/monitors"})
+
+	monitors, err = c.List(client, now.Add(2*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if monitors[0].Frequency != 60 {
+		t.Errorf("expected the verified monitor to be cached, got frequency %d", monitors[0].Frequency)
+	}
+}
+
+func TestVerifyDeleted(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	seed(server, 0, 120)
+
+	client := newClient(t, server)
+
+	c := newCache(t, Options{ConfigMap: "default/monitors"})
+
+	now := time.Now()
+
+	list(t, c, server, now, 120)
+
+	monitors, err := c.List(client, now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, _ := server.Monitor("https://000.example.com")
+
+	err = client.Synthetics.DeleteMonitor(first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitors, err = c.Verify(client, monitors, map[string]bool{"https://000.example.com": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(monitors) != 119 {
+		t.Errorf("expected the deleted monitor to be dropped, got %d monitors", len(monitors))
+	}
+
+	err = c.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requests := list(t, c, server, now.Add(2*time.Minute), 119); requests != 3 {
+		t.Errorf("expected every monitor to be listed, got %d requests", requests)
+	}
+}
+
+func TestPut(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	server := fake.New(1)
+	defer server.Close()
+
+	seed(server, 0, 10)
+
+	client := newClient(t, server)
+
+	c := newCache(t, Options{File: filepath.Join(dir, "monitors.json")})
+
+	now := time.Now()
+
+	monitors, err := c.List(client, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing := *monitors[0]
+	existing.Frequency = 60
+
+	updated, _, err := monitorutils.CreateOrUpdate(client, monitors, existing, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, _, err := monitorutils.CreateOrUpdate(client, monitors, synthetics.Monitor{
+		Name: "https://new.example.com",
+		Type: monitorutils.DefaultType,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Put(updated)
+	c.Put(created)
+
+	err = c.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c = newCache(t, Options{File: filepath.Join(dir, "monitors.json")})
+
+	monitors, err = c.List(client, now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(monitors) != 11 {
+		t.Fatalf("expected 11 monitors, got %d", len(monitors))
+	}
+
+	if monitors[0].Frequency != 60 {
+		t.Errorf("expected the updated monitor to be cached, got frequency %d", monitors[0].Frequency)
+	}
+
+	if monitors[10].ID != created.ID {
+		t.Errorf("expected the created monitor to be listed last, got %s", monitors[10].Name)
+	}
+}
+
+func TestNew(t *testing.T) {
+	c, err := New(Options{}, nil, 1)
+	if err != nil || c != nil {
+		t.Errorf("expected caching to be disabled, got %v and %v", c, err)
+	}
+
+	_, err = New(Options{File: "monitors.json", ConfigMap: "default/monitors"}, nil, 1)
+	if err == nil {
+		t.Error("expected an error when both stores are set")
+	}
+
+	_, err = New(Options{ConfigMap: "monitors"}, kubefake.New().Core, 1)
+	if err == nil {
+		t.Error("expected an error for a ConfigMap without a namespace")
+	}
+
+	_, err = New(Options{ConfigMap: "default/monitors"}, nil, 1)
+	if err == nil {
+		t.Error("expected an error for a ConfigMap without a cluster")
+	}
+}
@@ -0,0 +1,41 @@
+package monitor
+
+import (
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+)
+
+const (
+	// DefaultType of monitor which is provisioned.
+	DefaultType = synthetics.MonitorType("BROWSER")
+	// DefaultFrequency in minutes which a monitor runs.
+	DefaultFrequency = 1
+	// DefaultStatus of a monitor which is provisioned.
+	DefaultStatus = synthetics.MonitorStatusType("ENABLED")
+	// DefaultSLAThreshold in seconds for a monitor.
+	DefaultSLAThreshold = 7
+)
+
+const (
+	// FieldType is the type of monitor.
+	FieldType = "type"
+	// FieldFrequency is the frequency in minutes which the monitor runs.
+	FieldFrequency = "frequency"
+	// FieldURI is the URI which the monitor checks.
+	FieldURI = "uri"
+	// FieldLocations are the locations which the monitor runs from.
+	FieldLocations = "locations"
+	// FieldStatus is whether the monitor is enabled.
+	FieldStatus = "status"
+	// FieldSLAThreshold is the SLA threshold in seconds.
+	FieldSLAThreshold = "slaThreshold"
+)
+
+// OwnableFields which can be left to be tuned in the New Relic UI.
+// The name and URI identify a monitor, so they are always managed.
+var OwnableFields = []string{
+	FieldType,
+	FieldFrequency,
+	FieldLocations,
+	FieldStatus,
+	FieldSLAThreshold,
+}
@@ -1,42 +1,127 @@
 package monitor
 
 import (
-	"github.com/newrelic/newrelic-client-go/newrelic"
+	"net/url"
+	"strings"
+
 	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
 )
 
-func List(client *newrelic.NewRelic) ([]*synthetics.Monitor, error) {
+// PageSize is the most monitors which the API returns in a single page of a listing.
+const PageSize = 50
+
+// List every monitor in the account.
+func List(client *api.Client) ([]*synthetics.Monitor, error) {
+	monitors, _, err := ListFrom(client, 0)
+	return monitors, err
+}
+
+// ListFrom lists the monitors from an offset until the end of the listing, along with the offset where it ended.
+// Pages can be shorter than requested part way through a listing, so it only ends on an empty page, or once the
+// total reported by the API has been reached. Monitors which appear on more than one page because the
+// listing shifted are only returned once, and a page without any new monitors ends the listing so it can't loop.
+func ListFrom(client *api.Client, offset int) ([]*synthetics.Monitor, int, error) {
 	var monitors []*synthetics.Monitor
 
-	params := &synthetics.ListMonitorsParams{
-		Limit: 50,
-	}
+	seen := make(map[string]bool)
 
 	for {
-		list, err := client.Synthetics.ListMonitors(params)
+		list, err := Page(client, offset)
 		if err != nil {
-			return nil, err
+			return nil, offset, err
+		}
+
+		var added int
+
+		for _, monitor := range list.Monitors {
+			if monitor == nil || seen[monitor.ID] {
+				continue
+			}
+
+			seen[monitor.ID] = true
+			monitors = append(monitors, monitor)
+			added++
 		}
 
-		monitors = append(monitors, list.Monitors...)
+		offset += len(list.Monitors)
 
-		if list.Count != params.Limit {
+		if added == 0 {
 			break
 		}
 
-		params.Offset = params.Offset + list.Count
+		// The count is the total number of monitors, which is only certain once it is more than a page.
+		if offset >= list.Count && (len(list.Monitors) < PageSize || list.Count > len(list.Monitors)) {
+			break
+		}
 	}
 
-	return monitors, nil
+	return monitors, offset, nil
 }
 
-func CreateOrUpdate(client *newrelic.NewRelic, monitors []*synthetics.Monitor, monitor synthetics.Monitor) (*synthetics.Monitor, error) {
-	if id, exists := Exists(monitors, monitor.Name); exists {
-		monitor.ID = id
-		return client.Synthetics.UpdateMonitor(monitor)
+// Page of monitors starting at an offset.
+func Page(client *api.Client, offset int) (synthetics.ListMonitorsResponse, error) {
+	var list synthetics.ListMonitorsResponse
+
+	params := &synthetics.ListMonitorsParams{
+		Limit:  PageSize,
+		Offset: offset,
 	}
 
-	return client.Synthetics.CreateMonitor(monitor)
+	err := client.Call("Synthetics.ListMonitors", func() error {
+		var err error
+		list, err = client.Synthetics.ListMonitors(params)
+		return err
+	})
+
+	return list, err
+}
+
+// CreateOrUpdate a monitor, returning true if the monitor was created.
+// Only the owned fields of an existing monitor are updated, every field is owned when none are given.
+func CreateOrUpdate(client *api.Client, monitors []*synthetics.Monitor, monitor synthetics.Monitor, owned []string) (*synthetics.Monitor, bool, error) {
+	var (
+		m   *synthetics.Monitor
+		err error
+	)
+
+	if existing, exists := Find(monitors, monitor.Name); exists {
+		monitor = Merge(*existing, monitor, owned)
+		monitor.ID = existing.ID
+
+		err = client.Call("Synthetics.UpdateMonitor", func() error {
+			m, err = client.Synthetics.UpdateMonitor(monitor)
+			return err
+		})
+
+		return m, false, err
+	}
+
+	err = client.Create("Synthetics.CreateMonitor", func() error {
+		m, err = client.Synthetics.CreateMonitor(monitor)
+		return err
+	})
+	if err != nil && api.Ambiguous(err) {
+		return findCreated(client, monitor.Name, err)
+	}
+
+	return m, true, err
+}
+
+// Helper function to look for a monitor after a create failed in a way where it may still have been created,
+// instead of retrying the create and ending up with a duplicate.
+func findCreated(client *api.Client, name string, cause error) (*synthetics.Monitor, bool, error) {
+	monitors, err := List(client)
+	if err != nil {
+		return nil, false, cause
+	}
+
+	if existing, exists := Find(monitors, name); exists {
+		return existing, true, nil
+	}
+
+	return nil, false, cause
 }
 
 // Helper function to check if the monitor already exists.
@@ -49,3 +134,130 @@ func Exists(monitors []*synthetics.Monitor, name string) (string, bool) {
 
 	return "", false
 }
+
+// Merge the owned fields of the desired monitor into an existing monitor, preserving the rest of the existing monitor.
+// Every field is owned when none are given.
+func Merge(existing, desired synthetics.Monitor, owned []string) synthetics.Monitor {
+	if len(owned) == 0 {
+		return desired
+	}
+
+	merged := existing
+	merged.Name = desired.Name
+	merged.URI = desired.URI
+
+	for _, field := range owned {
+		switch field {
+		case FieldType:
+			merged.Type = desired.Type
+		case FieldFrequency:
+			merged.Frequency = desired.Frequency
+		case FieldLocations:
+			merged.Locations = desired.Locations
+		case FieldStatus:
+			merged.Status = desired.Status
+		case FieldSLAThreshold:
+			merged.SLAThreshold = desired.SLAThreshold
+		}
+	}
+
+	return merged
+}
+
+// Find the monitor with a name.
+func Find(monitors []*synthetics.Monitor, name string) (*synthetics.Monitor, bool) {
+	for _, monitor := range monitors {
+		if monitor.Name == name {
+			return monitor, true
+		}
+	}
+
+	return nil, false
+}
+
+// UpdateScript for a scripted monitor. The client takes care of encoding the script.
+func UpdateScript(client *api.Client, id, script string) error {
+	return client.Call("Synthetics.UpdateMonitorScript", func() error {
+		_, err := client.Synthetics.UpdateMonitorScript(id, synthetics.MonitorScript{
+			Text: script,
+		})
+		return err
+	})
+}
+
+// Locations which monitors can be provisioned in.
+func Locations(client *api.Client) ([]string, error) {
+	var list []*synthetics.MonitorLocation
+
+	err := client.Call("Synthetics.GetMonitorLocations", func() error {
+		var err error
+		list, err = client.Synthetics.GetMonitorLocations()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]string, len(list))
+
+	for i, location := range list {
+		locations[i] = location.Name
+	}
+
+	return locations, nil
+}
+
+// Get a single monitor.
+func Get(client *api.Client, id string) (*synthetics.Monitor, error) {
+	var monitor *synthetics.Monitor
+
+	err := client.Call("Synthetics.GetMonitor", func() error {
+		var err error
+		monitor, err = client.Synthetics.GetMonitor(id)
+		return err
+	})
+
+	return monitor, err
+}
+
+// SetStatus of an existing monitor eg. to disable it.
+func SetStatus(client *api.Client, id string, status synthetics.MonitorStatusType) error {
+	monitor, err := Get(client, id)
+	if err != nil {
+		return err
+	}
+
+	if monitor.Status == status {
+		return nil
+	}
+
+	monitor.Status = status
+
+	return client.Call("Synthetics.UpdateMonitor", func() error {
+		_, err := client.Synthetics.UpdateMonitor(*monitor)
+		return err
+	})
+}
+
+// NormaliseURI so that monitors which were created by hand can be matched to a Route regardless of scheme,
+// case, default ports or trailing slashes. An empty string is returned if the URI can't be parsed.
+func NormaliseURI(uri string) string {
+	u, err := url.Parse(strings.TrimSpace(uri))
+	if err != nil || u.Host == "" {
+		return ""
+	}
+
+	normalised := strings.ToLower(u.Hostname())
+
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		normalised = normalised + ":" + port
+	}
+
+	normalised = normalised + strings.TrimRight(u.Path, "/")
+
+	if u.RawQuery != "" {
+		normalised = normalised + "?" + u.RawQuery
+	}
+
+	return normalised
+}
@@ -0,0 +1,321 @@
+package monitor
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/api"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/fake"
+)
+
+func newClient(t *testing.T, server *fake.Server) *api.Client {
+	client, err := api.New(api.Limits{Retries: 2, Backoff: time.Millisecond}, server.Options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func seed(server *fake.Server, count int) {
+	for i := 0; i < count; i++ {
+		server.AddMonitor(synthetics.Monitor{
+			Name: fmt.Sprintf("https://%03d.example.com", i),
+			Type: DefaultType,
+		})
+	}
+}
+
+func TestListPaginates(t *testing.T) {
+	tests := []struct {
+		count    int
+		requests int
+	}{
+		{count: 0, requests: 1},
+		{count: 49, requests: 1},
+		{count: 50, requests: 2},
+		{count: 120, requests: 3},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.count), func(t *testing.T) {
+			server := fake.New(1)
+			defer server.Close()
+
+			seed(server, test.count)
+
+			monitors, err := List(newClient(t, server))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(monitors) != test.count {
+				t.Errorf("expected %d monitors, got %d", test.count, len(monitors))
+			}
+
+			seen := make(map[string]bool, len(monitors))
+
+			for _, monitor := range monitors {
+				if seen[monitor.ID] {
+					t.Errorf("monitor %s was listed twice", monitor.ID)
+				}
+
+				seen[monitor.ID] = true
+			}
+
+			if requests := server.Requests(fake.OperationListMonitors); requests != test.requests {
+				t.Errorf("expected %d requests, got %d", test.requests, requests)
+			}
+		})
+	}
+}
+
+func TestListShortPages(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	seed(server, 120)
+
+	// Pages which are shorter than the limit part way through the listing must not end it early.
+	server.MonitorPageSize = 20
+
+	monitors, err := List(newClient(t, server))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(monitors) != 120 {
+		t.Errorf("expected 120 monitors, got %d", len(monitors))
+	}
+
+	if requests := server.Requests(fake.OperationListMonitors); requests != 6 {
+		t.Errorf("expected 6 requests, got %d", requests)
+	}
+}
+
+func TestListFrom(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	seed(server, 70)
+
+	monitors, offset, err := ListFrom(newClient(t, server), 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(monitors) != 30 || offset != 70 {
+		t.Fatalf("expected 30 monitors ending at 70, got %d ending at %d", len(monitors), offset)
+	}
+
+	if monitors[0].Name != "https://040.example.com" {
+		t.Errorf("expected the listing to start at the offset, got %s", monitors[0].Name)
+	}
+}
+
+func TestListReturnsErrors(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	seed(server, 60)
+
+	server.Fail(fake.OperationListMonitors, http.StatusBadRequest, 1)
+
+	_, err := List(newClient(t, server))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestCreateOrUpdate(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	client := newClient(t, server)
+
+	monitor := synthetics.Monitor{
+		Name:      "https://example.com",
+		Type:      DefaultType,
+		Frequency: DefaultFrequency,
+		URI:       "https://example.com",
+		Locations: []string{"AWS_AP_SOUTHEAST_2"},
+		Status:    DefaultStatus,
+	}
+
+	m, created, err := CreateOrUpdate(client, nil, monitor, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !created || m.ID == "" {
+		t.Fatalf("expected the monitor to be created, got %+v", m)
+	}
+
+	monitors, err := List(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitor.Frequency = 5
+
+	updated, created, err := CreateOrUpdate(client, monitors, monitor, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if created || updated.ID != m.ID {
+		t.Fatalf("expected monitor %s to be updated, got %+v", m.ID, updated)
+	}
+
+	stored, _ := server.Monitor(monitor.Name)
+	if stored.Frequency != 5 {
+		t.Errorf("expected frequency 5, got %d", stored.Frequency)
+	}
+}
+
+func TestCreateIsNotRetried(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	server.Fail(fake.OperationCreateMonitor, http.StatusServiceUnavailable, 1)
+
+	_, _, err := CreateOrUpdate(newClient(t, server), nil, synthetics.Monitor{
+		Name: "https://example.com",
+		Type: DefaultType,
+	}, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if requests := server.Requests(fake.OperationCreateMonitor); requests != 1 {
+		t.Errorf("expected the create to be attempted once, got %d requests", requests)
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 0 {
+		t.Errorf("expected no monitors, got %d", len(monitors))
+	}
+}
+
+func TestCreateFindsAmbiguousCreate(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	server.FailLate(fake.OperationCreateMonitor, http.StatusGatewayTimeout, 1)
+
+	m, created, err := CreateOrUpdate(newClient(t, server), nil, synthetics.Monitor{
+		Name: "https://example.com",
+		Type: DefaultType,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored, ok := server.Monitor("https://example.com")
+	if !ok || !created || m.ID != stored.ID {
+		t.Fatalf("expected the monitor which was created to be found, got %+v", m)
+	}
+
+	if monitors := server.Monitors(); len(monitors) != 1 {
+		t.Errorf("expected 1 monitor, got %d", len(monitors))
+	}
+}
+
+func TestCreateOrUpdateOwnedFields(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	client := newClient(t, server)
+
+	server.AddMonitor(synthetics.Monitor{
+		Name:      "https://example.com",
+		Type:      DefaultType,
+		Frequency: 15,
+		URI:       "https://example.com",
+		Locations: []string{"AWS_US_WEST_1"},
+		Status:    synthetics.MonitorStatus.Muted,
+		Options: synthetics.MonitorOptions{
+			ValidationString: "Welcome",
+		},
+	})
+
+	monitors, err := List(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = CreateOrUpdate(client, monitors, synthetics.Monitor{
+		Name:      "https://example.com",
+		Type:      DefaultType,
+		Frequency: DefaultFrequency,
+		URI:       "https://example.com",
+		Locations: []string{"AWS_AP_SOUTHEAST_2"},
+		Status:    DefaultStatus,
+	}, []string{FieldLocations})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored, _ := server.Monitor("https://example.com")
+
+	if len(stored.Locations) != 1 || stored.Locations[0] != "AWS_AP_SOUTHEAST_2" {
+		t.Errorf("expected owned locations to be updated, got %v", stored.Locations)
+	}
+
+	if stored.Frequency != 15 {
+		t.Errorf("expected frequency 15 to be preserved, got %d", stored.Frequency)
+	}
+
+	if stored.Status != synthetics.MonitorStatus.Muted {
+		t.Errorf("expected status %s to be preserved, got %s", synthetics.MonitorStatus.Muted, stored.Status)
+	}
+
+	if stored.Options.ValidationString != "Welcome" {
+		t.Errorf("expected options to be preserved, got %+v", stored.Options)
+	}
+}
+
+func TestUpdateScript(t *testing.T) {
+	server := fake.New(1)
+	defer server.Close()
+
+	id := server.AddMonitor(synthetics.Monitor{
+		Name: "journey",
+		Type: synthetics.MonitorTypes.ScriptedBrowser,
+	})
+
+	script := "$browser.get('https://example.com');"
+
+	err := UpdateScript(newClient(t, server), id, script)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := server.Script(id); got != script {
+		t.Errorf("expected script %q, got %q", script, got)
+	}
+}
+
+func TestNormaliseURI(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want string
+	}{
+		{uri: "https://example.com", want: "example.com"},
+		{uri: "http://EXAMPLE.com:80/", want: "example.com"},
+		{uri: "https://example.com:443/path/", want: "example.com/path"},
+		{uri: "https://example.com:8443/path?a=b", want: "example.com:8443/path?a=b"},
+		{uri: " https://example.com ", want: "example.com"},
+		{uri: "not a url", want: ""},
+		{uri: "", want: ""},
+	}
+
+	for _, test := range tests {
+		if got := NormaliseURI(test.uri); got != test.want {
+			t.Errorf("NormaliseURI(%q) = %q, want %q", test.uri, got, test.want)
+		}
+	}
+}
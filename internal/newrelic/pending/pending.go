@@ -0,0 +1,186 @@
+// Package pending queues the tags for monitors whose entities had not been indexed yet, so a later run can apply
+// them even if the Route has been deleted in the meantime. Monitors without tags are invisible to cleanup.
+package pending
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+	"gopkg.in/alecthomas/kingpin.v2"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/state"
+)
+
+// Options which configure where the queue is stored.
+type Options struct {
+	File string
+	// ConfigMap which the queue is stored in, in the form "namespace/name".
+	ConfigMap string
+}
+
+// Flags which configure the queue for a command.
+func (o *Options) Flags(command *kingpin.CmdClause) {
+	command.Flag("pending-tags-file", "File which the tags for monitors which have not been indexed yet are queued in").Envar("PENDING_TAGS_FILE").StringVar(&o.File)
+	command.Flag("pending-tags-configmap", "ConfigMap which the tags for monitors which have not been indexed yet are queued in eg. namespace/name").Envar("PENDING_TAGS_CONFIGMAP").StringVar(&o.ConfigMap)
+}
+
+// Entry for a monitor which is waiting for its tags.
+type Entry struct {
+	Namespace string         `json:"namespace"`
+	Route     string         `json:"route"`
+	Name      string         `json:"name"`
+	MonitorID string         `json:"monitorId"`
+	Tags      []entities.Tag `json:"tags"`
+	QueuedAt  time.Time      `json:"queuedAt"`
+}
+
+// Queue of monitors which are waiting for their tags, for a single namespace.
+// Without a store the queue is only kept in memory, which still covers runs made with an interval.
+type Queue struct {
+	store state.Store
+
+	mu      sync.Mutex
+	entries map[string]Entry
+}
+
+// New queue for a namespace. The client is only used when the queue is stored in a ConfigMap,
+// where each namespace is stored under its own key so the ConfigMap can be shared.
+func New(opts Options, client coreclient.ConfigMapsGetter, namespace string) (*Queue, error) {
+	if opts.File != "" && opts.ConfigMap != "" {
+		return nil, fmt.Errorf("pending tags can be stored in a file or a ConfigMap, not both")
+	}
+
+	q := &Queue{
+		entries: make(map[string]Entry),
+	}
+
+	switch {
+	case opts.File != "":
+		q.store = state.File(opts.File)
+	case opts.ConfigMap != "":
+		if client == nil {
+			return nil, fmt.Errorf("pending tags can't be stored in a ConfigMap without a cluster")
+		}
+
+		store, err := state.ConfigMap(client, opts.ConfigMap, fmt.Sprintf("pending-tags-%s.json.gz", namespace))
+		if err != nil {
+			return nil, err
+		}
+
+		q.store = store
+	}
+
+	return q, nil
+}
+
+// Entries which are waiting for their tags, sorted by name. The queue is reloaded from its store first,
+// so entries queued by the previous run are included.
+func (q *Queue) Entries() ([]Entry, error) {
+	if q == nil {
+		return nil, nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.store != nil {
+		var list []Entry
+
+		_, err := q.store.Load(&list)
+		if err != nil {
+			return nil, err
+		}
+
+		q.entries = make(map[string]Entry, len(list))
+
+		for _, entry := range list {
+			q.entries[entry.MonitorID] = entry
+		}
+	}
+
+	return q.list(), nil
+}
+
+// Add an entry to the queue. A monitor which is already queued keeps the time it was first queued.
+func (q *Queue) Add(entry Entry) {
+	if q == nil {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if existing, ok := q.entries[entry.MonitorID]; ok {
+		entry.QueuedAt = existing.QueuedAt
+	}
+
+	q.entries[entry.MonitorID] = entry
+}
+
+// Remove the entry for a monitor, once it has been tagged or no longer exists.
+func (q *Queue) Remove(monitorID string) {
+	if q == nil {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.entries, monitorID)
+}
+
+// Len is the number of monitors which are waiting for their tags.
+func (q *Queue) Len() int {
+	if q == nil {
+		return 0
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.entries)
+}
+
+// Save the queue so the next run can drain it.
+func (q *Queue) Save() error {
+	if q == nil || q.store == nil {
+		return nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.store.Save(q.list())
+}
+
+// String describes where the queue is stored.
+func (q *Queue) String() string {
+	if q == nil || q.store == nil {
+		return "memory"
+	}
+
+	return q.store.String()
+}
+
+// Helper function to list the entries sorted by name. The lock must be held.
+func (q *Queue) list() []Entry {
+	list := make([]Entry, 0, len(q.entries))
+
+	for _, entry := range q.entries {
+		list = append(list, entry)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Name != list[j].Name {
+			return list[i].Name < list[j].Name
+		}
+
+		return list[i].MonitorID < list[j].MonitorID
+	})
+
+	return list
+}
@@ -0,0 +1,77 @@
+package pending
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+)
+
+func TestQueue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pending")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	opts := Options{File: filepath.Join(dir, "pending.json")}
+
+	q, err := New(opts, nil, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	q.Add(Entry{Name: "https://b.example.com", MonitorID: "b", QueuedAt: first})
+	q.Add(Entry{Name: "https://a.example.com", MonitorID: "a", QueuedAt: first})
+
+	// Queueing a monitor again keeps the time it was first queued, along with the latest tags.
+	q.Add(Entry{
+		Name:      "https://b.example.com",
+		MonitorID: "b",
+		Tags:      []entities.Tag{{Key: "openshiftRouteName", Values: []string{"b"}}},
+		QueuedAt:  first.Add(time.Hour),
+	})
+
+	q.Remove("a")
+
+	err = q.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q, err = New(opts, nil, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := q.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 || entries[0].MonitorID != "b" {
+		t.Fatalf("expected only b to be queued, got %+v", entries)
+	}
+
+	if !entries[0].QueuedAt.Equal(first) || len(entries[0].Tags) != 1 {
+		t.Errorf("expected b to keep when it was first queued along with its latest tags, got %+v", entries[0])
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Options{File: "pending.json", ConfigMap: "default/pending"}, nil, "test")
+	if err == nil {
+		t.Error("expected an error when both stores are set")
+	}
+
+	_, err = New(Options{ConfigMap: "default/pending"}, nil, "test")
+	if err == nil {
+		t.Error("expected an error for a ConfigMap without a cluster")
+	}
+}
@@ -3,4 +3,26 @@ package route
 const (
 	// AnnotationIPWhitelist used when for skipping routes.
 	AnnotationIPWhitelist = "haproxy.router.openshift.io/ip_whitelist"
+
+	// AnnotationEnabled toggles whether a Route is monitored.
+	AnnotationEnabled = "synthetics.codedrop.com.au/enabled"
+	// AnnotationType overrides the type of monitor provisioned for a Route.
+	AnnotationType = "synthetics.codedrop.com.au/type"
+	// AnnotationFrequency overrides the frequency in minutes of the monitor provisioned for a Route.
+	AnnotationFrequency = "synthetics.codedrop.com.au/frequency"
+	// AnnotationLocations overrides the comma separated locations of the monitor provisioned for a Route.
+	AnnotationLocations = "synthetics.codedrop.com.au/locations"
+	// AnnotationSLAThreshold overrides the SLA threshold in seconds of the monitor provisioned for a Route.
+	AnnotationSLAThreshold = "synthetics.codedrop.com.au/sla-threshold"
+	// AnnotationAlertPolicyID overrides the alert policy which a condition for the monitor is added to.
+	AnnotationAlertPolicyID = "synthetics.codedrop.com.au/alert-policy-id"
+	// AnnotationManagedFields overrides the comma separated fields of the monitor which are kept in sync for a Route.
+	AnnotationManagedFields = "synthetics.codedrop.com.au/managed-fields"
+
+	// AnnotationMonitorID is written back to a Route with the ID of its monitor.
+	AnnotationMonitorID = "synthetics.codedrop.com.au/monitor-id"
+	// AnnotationEntityGUID is written back to a Route with the entity GUID of its monitor.
+	AnnotationEntityGUID = "synthetics.codedrop.com.au/entity-guid"
+	// AnnotationMonitorURL is written back to a Route with the link to its monitor in the New Relic UI.
+	AnnotationMonitorURL = "synthetics.codedrop.com.au/monitor-url"
 )
@@ -0,0 +1,180 @@
+package route
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Stdin is the path used to load Routes from stdin, which can only be read once.
+const Stdin = "-"
+
+// Extensions of the manifests which are loaded from a directory.
+var Extensions = []string{".yaml", ".yml", ".json"}
+
+// Load the Routes in a namespace from a file or a directory of manifests instead of a cluster.
+// Manifests can contain multiple documents eg. Kustomize build output, as well as Lists eg. "oc get routes -o yaml".
+// Routes without a namespace are assumed to belong to the namespace being loaded.
+func Load(path, namespace string) ([]routev1.Route, error) {
+	files, err := manifests(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []routev1.Route
+
+	for _, file := range files {
+		list, err := loadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load routes from %s: %w", file, err)
+		}
+
+		for _, route := range list {
+			if route.ObjectMeta.Namespace == "" {
+				route.ObjectMeta.Namespace = namespace
+			}
+
+			if route.ObjectMeta.Namespace != namespace {
+				continue
+			}
+
+			routes = append(routes, route)
+		}
+	}
+
+	return routes, nil
+}
+
+// Helper function to discover the manifests for a path, "-" is used for stdin.
+func manifests(path string) ([]string, error) {
+	if path == Stdin {
+		return []string{path}, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+
+	err = filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		for _, ext := range Extensions {
+			if strings.EqualFold(filepath.Ext(file), ext) {
+				files = append(files, file)
+				break
+			}
+		}
+
+		return nil
+	})
+
+	return files, err
+}
+
+// Helper function to load the Routes from a single manifest.
+func loadFile(file string) ([]routev1.Route, error) {
+	var r io.Reader = os.Stdin
+
+	if file != Stdin {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+
+		defer f.Close()
+
+		r = f
+	}
+
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+
+	var routes []routev1.Route
+
+	for {
+		var raw json.RawMessage
+
+		err := decoder.Decode(&raw)
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := decode(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		routes = append(routes, list...)
+	}
+
+	return routes, nil
+}
+
+// Helper function to decode a single object, which may be a Route or a List of objects.
+func decode(raw json.RawMessage) ([]routev1.Route, error) {
+	// Empty documents are common in generated manifests eg. a trailing "---".
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var object struct {
+		metav1.TypeMeta `json:",inline"`
+		Items           []json.RawMessage `json:"items"`
+	}
+
+	err := json.Unmarshal(raw, &object)
+	if err != nil {
+		return nil, err
+	}
+
+	switch object.Kind {
+	case "Route":
+		var route routev1.Route
+
+		err := json.Unmarshal(raw, &route)
+		if err != nil {
+			return nil, err
+		}
+
+		return []routev1.Route{route}, nil
+
+	case "List", "RouteList":
+		var routes []routev1.Route
+
+		for _, item := range object.Items {
+			list, err := decode(item)
+			if err != nil {
+				return nil, err
+			}
+
+			routes = append(routes, list...)
+		}
+
+		return routes, nil
+	}
+
+	return nil, nil
+}
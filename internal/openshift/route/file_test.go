@@ -0,0 +1,114 @@
+package route
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const list = `apiVersion: v1
+kind: List
+items:
+- apiVersion: route.openshift.io/v1
+  kind: Route
+  metadata:
+    name: web
+    namespace: test
+  spec:
+    host: web.example.com
+- apiVersion: route.openshift.io/v1
+  kind: Route
+  metadata:
+    name: elsewhere
+    namespace: other
+  spec:
+    host: elsewhere.example.com
+`
+
+const kustomize = `---
+apiVersion: v1
+kind: Service
+metadata:
+  name: api
+---
+apiVersion: route.openshift.io/v1
+kind: Route
+metadata:
+  name: api
+spec:
+  host: api.example.com
+  tls:
+    termination: edge
+---
+`
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "routes")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"list.yaml":        list,
+		"nested/build.yml": kustomize,
+		"nested/README.md": "not a manifest",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = ioutil.WriteFile(path, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	routes, err := Load(dir, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	urls := make(map[string]bool)
+
+	for _, route := range routes {
+		if route.ObjectMeta.Namespace != "test" {
+			t.Errorf("expected route %s to be in namespace test, got %s", route.ObjectMeta.Name, route.ObjectMeta.Namespace)
+		}
+
+		uri := URL(route)
+		urls[uri.String()] = true
+	}
+
+	if len(routes) != 2 || !urls["http://web.example.com"] || !urls["https://api.example.com"] {
+		t.Errorf("unexpected routes: %v", urls)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	f, err := ioutil.TempFile("", "routes*.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString("kind: Route\nmetadata: [\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.Close()
+
+	_, err = Load(f.Name(), "test")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
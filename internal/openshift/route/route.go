@@ -2,27 +2,106 @@ package route
 
 import (
 	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+
 	routev1 "github.com/openshift/api/route/v1"
 	clientset "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/apimachinery/pkg/types"
 )
 
-func List(master, configPath, namespace string) ([]routev1.Route, error) {
-	config, err := clientcmd.BuildConfigFromFlags(master, configPath)
+// List the Routes in a namespace.
+func List(client clientset.RoutesGetter, namespace string) ([]routev1.Route, error) {
+	routes, err := client.Routes(namespace).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := clientset.NewForConfig(config)
-	if err != nil {
-		return nil, err
+	return routes.Items, nil
+}
+
+// Get a single Route.
+func Get(client clientset.RoutesGetter, namespace, name string) (*routev1.Route, error) {
+	return client.Routes(namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
+
+// Annotate a Route, skipping the update if the annotations are already set.
+func Annotate(client clientset.RoutesGetter, route routev1.Route, annotations map[string]string) error {
+	changed := make(map[string]string)
+
+	for key, value := range annotations {
+		if route.ObjectMeta.Annotations[key] != value {
+			changed[key] = value
+		}
 	}
 
-	routes, err := client.Routes(namespace).List(context.Background(), metav1.ListOptions{})
+	if len(changed) == 0 {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": changed,
+		},
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return routes.Items, nil
+	_, err = client.Routes(route.ObjectMeta.Namespace).Patch(context.Background(), route.ObjectMeta.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// Reference to a Route for use when recording Kubernetes Events.
+func Reference(route routev1.Route) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion:      routev1.SchemeGroupVersion.String(),
+		Kind:            "Route",
+		Namespace:       route.ObjectMeta.Namespace,
+		Name:            route.ObjectMeta.Name,
+		UID:             route.ObjectMeta.UID,
+		ResourceVersion: route.ObjectMeta.ResourceVersion,
+	}
+}
+
+// URL which a Route is served on.
+func URL(route routev1.Route) url.URL {
+	uri := url.URL{
+		Scheme: "http", // @todo, Find a cost.
+		Host:   route.Spec.Host,
+		Path:   route.Spec.Path,
+	}
+
+	if route.Spec.TLS != nil {
+		uri.Scheme = "https" // @todo, Find a cost.
+	}
+
+	return uri
+}
+
+// Group Routes by the URL they are served on, so Routes which share a URL (eg. blue/green Routes) share a monitor.
+// Routes in each group are sorted by namespace and name, so the first Route is stable between runs.
+func Group(routes []routev1.Route) map[string][]routev1.Route {
+	groups := make(map[string][]routev1.Route, len(routes))
+
+	for _, route := range routes {
+		uri := URL(route)
+		groups[uri.String()] = append(groups[uri.String()], route)
+	}
+
+	for _, group := range groups {
+		sort.SliceStable(group, func(i, j int) bool {
+			if group[i].ObjectMeta.Namespace != group[j].ObjectMeta.Namespace {
+				return group[i].ObjectMeta.Namespace < group[j].ObjectMeta.Namespace
+			}
+
+			return group[i].ObjectMeta.Name < group[j].ObjectMeta.Name
+		})
+	}
+
+	return groups
 }
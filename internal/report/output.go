@@ -0,0 +1,200 @@
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+const (
+	// FormatJSON writes the report as JSON.
+	FormatJSON = "json"
+	// FormatJUnit writes the report as JUnit XML so it can be consumed by CI pipelines.
+	FormatJUnit = "junit"
+)
+
+// Output which a report will be written to.
+type Output struct {
+	Path   string
+	Format string
+}
+
+// Flags which configure where a report will be written.
+func (o *Output) Flags(command *kingpin.CmdClause) {
+	command.Flag("report", "Path to write a machine readable report of every target").Envar("REPORT").StringVar(&o.Path)
+	command.Flag("report-format", "Format of the report").Envar("REPORT_FORMAT").Default(FormatJSON).EnumVar(&o.Format, FormatJSON, FormatJUnit)
+}
+
+// Write the report if an output path has been configured.
+func (o *Output) Write(r *Report, name string) error {
+	if o.Path == "" {
+		return nil
+	}
+
+	f, err := os.Create(o.Path)
+	if err != nil {
+		return fmt.Errorf("failed to create report: %w", err)
+	}
+
+	defer f.Close()
+
+	switch o.Format {
+	case FormatJUnit:
+		err = r.WriteJUnit(f, name)
+	default:
+		err = r.WriteJSON(f)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return f.Close()
+}
+
+type jsonResult struct {
+	Namespace string              `json:"namespace,omitempty"`
+	Route     string              `json:"route,omitempty"`
+	URL       string              `json:"url,omitempty"`
+	Monitor   string              `json:"monitor,omitempty"`
+	MonitorID string              `json:"monitorId,omitempty"`
+	GUID      string              `json:"guid,omitempty"`
+	Permalink string              `json:"permalink,omitempty"`
+	Object    string              `json:"object,omitempty"`
+	Tags      map[string][]string `json:"tags,omitempty"`
+	Drift     []Difference        `json:"drift,omitempty"`
+	Action    Action              `json:"action"`
+	Reason    string              `json:"reason,omitempty"`
+	Error     string              `json:"error,omitempty"`
+}
+
+// WriteJSON writes every result in the report as JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	results := []jsonResult{}
+
+	for _, result := range r.Results() {
+		item := jsonResult{
+			Namespace: result.Namespace,
+			Route:     result.Route,
+			URL:       result.URL,
+			Monitor:   result.Monitor,
+			MonitorID: result.MonitorID,
+			GUID:      result.GUID,
+			Permalink: result.Permalink,
+			Object:    result.Object,
+			Drift:     result.Drift,
+			Action:    result.Action,
+			Reason:    result.Reason,
+		}
+
+		if len(result.Tags) > 0 {
+			item.Tags = make(map[string][]string, len(result.Tags))
+
+			for _, tag := range result.Tags {
+				item.Tags[tag.Key] = append(item.Tags[tag.Key], tag.Values...)
+			}
+		}
+
+		if result.Error != nil {
+			item.Error = result.Error.Error()
+		}
+
+		results = append(results, item)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(struct {
+		Results []jsonResult `json:"results"`
+	}{
+		Results: results,
+	})
+}
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// WriteJUnit writes every result in the report as a JUnit test suite.
+func (r *Report) WriteJUnit(w io.Writer, name string) error {
+	suite := junitTestSuite{
+		Name: name,
+	}
+
+	for _, result := range r.Results() {
+		testcase := junitTestCase{
+			Name:      result.Monitor,
+			ClassName: result.Namespace,
+			SystemOut: fmt.Sprintf("action=%s route=%s url=%s monitor_id=%s guid=%s", result.Action, result.Route, result.URL, result.MonitorID, result.GUID),
+		}
+
+		if result.Route != "" {
+			testcase.Name = result.Route
+		}
+
+		for _, difference := range result.Drift {
+			testcase.SystemOut = testcase.SystemOut + fmt.Sprintf(" drift.%s=%q->%q", difference.Field, difference.Live, difference.Desired)
+		}
+
+		if result.Object != "" {
+			testcase.Name = testcase.Name + " " + result.Object
+		}
+
+		switch {
+		case result.Error != nil:
+			suite.Failures++
+			testcase.Failure = &junitMessage{
+				Message: result.Error.Error(),
+				Body:    result.Error.Error(),
+			}
+		case result.Action == ActionSkipped:
+			suite.Skipped++
+			testcase.Skipped = &junitMessage{
+				Message: result.Reason,
+			}
+		}
+
+		suite.Tests++
+		suite.TestCases = append(suite.TestCases, testcase)
+	}
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	err = encoder.Encode(suite)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n")
+
+	return err
+}
@@ -0,0 +1,191 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/newrelic/newrelic-client-go/pkg/entities"
+)
+
+// Action which was taken for a target.
+type Action string
+
+const (
+	// ActionCreated is used when a monitor was created.
+	ActionCreated Action = "created"
+	// ActionUpdated is used when a monitor was updated.
+	ActionUpdated Action = "updated"
+	// ActionDeleted is used when a monitor was deleted.
+	ActionDeleted Action = "deleted"
+	// ActionAdopted is used when a monitor which was created by hand was brought under management.
+	ActionAdopted Action = "adopted"
+	// ActionDisabled is used when a monitor was disabled ahead of being deleted.
+	ActionDisabled Action = "disabled"
+	// ActionDrifted is used when a monitor has drifted from its desired state and was left as it is.
+	ActionDrifted Action = "drifted"
+	// ActionSkipped is used when no changes were required.
+	ActionSkipped Action = "skipped"
+	// ActionDryRun is used when changes were required but dry run is enabled.
+	ActionDryRun Action = "dry-run"
+	// ActionFailed is used when an error occurred.
+	ActionFailed Action = "failed"
+)
+
+const (
+	// ExitCodeFailed is used when every target failed.
+	ExitCodeFailed = 1
+	// ExitCodePartial is used when some targets failed and others succeeded.
+	ExitCodePartial = 2
+)
+
+// Result for a single target.
+type Result struct {
+	Namespace string
+	Route     string
+	URL       string
+	Monitor   string
+	MonitorID string
+	GUID      string
+	Permalink string
+	Object    string
+	Tags      []entities.Tag
+	Drift     []Difference
+	Action    Action
+	Reason    string
+	Error     error
+}
+
+// Difference between the desired and live value of a monitor field.
+type Difference struct {
+	Field   string `json:"field"`
+	Desired string `json:"desired"`
+	Live    string `json:"live"`
+}
+
+// Report which collects the results of a run.
+type Report struct {
+	mu      sync.Mutex
+	results []*Result
+}
+
+// New report.
+func New() *Report {
+	return &Report{}
+}
+
+// Add a result to the report.
+func (r *Report) Add(result *Result) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.results = append(r.results, result)
+}
+
+// Results which have been added to the report, sorted by namespace, route and monitor.
+func (r *Report) Results() []*Result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	results := make([]*Result, len(r.results))
+	copy(results, r.results)
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Namespace != results[j].Namespace {
+			return results[i].Namespace < results[j].Namespace
+		}
+
+		if results[i].Route != results[j].Route {
+			return results[i].Route < results[j].Route
+		}
+
+		if results[i].Monitor != results[j].Monitor {
+			return results[i].Monitor < results[j].Monitor
+		}
+
+		return results[i].Object < results[j].Object
+	})
+
+	return results
+}
+
+// Failed results in the report.
+func (r *Report) Failed() []*Result {
+	var failed []*Result
+
+	for _, result := range r.Results() {
+		if result.Error != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// Summary prints the number of results for each action followed by a table of failures.
+func (r *Report) Summary(w io.Writer) error {
+	results := r.Results()
+
+	counts := make(map[Action]int)
+
+	for _, result := range results {
+		counts[result.Action]++
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "ACTION\tCOUNT")
+
+	for _, action := range []Action{ActionCreated, ActionUpdated, ActionAdopted, ActionDeleted, ActionDisabled, ActionDrifted, ActionSkipped, ActionDryRun, ActionFailed} {
+		if counts[action] > 0 {
+			fmt.Fprintf(tw, "%s\t%d\n", action, counts[action])
+		}
+	}
+
+	failed := r.Failed()
+
+	if len(failed) > 0 {
+		fmt.Fprintln(tw)
+		fmt.Fprintln(tw, "NAMESPACE\tROUTE\tMONITOR\tOBJECT\tERROR")
+
+		for _, result := range failed {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", result.Namespace, result.Route, result.Monitor, result.Object, result.Error)
+		}
+	}
+
+	return tw.Flush()
+}
+
+// Err returns an error if any of the results failed.
+func (r *Report) Err() error {
+	failed := len(r.Failed())
+	if failed == 0 {
+		return nil
+	}
+
+	return &Error{
+		Failed: failed,
+		Total:  len(r.Results()),
+	}
+}
+
+// Error returned when one or more targets failed.
+type Error struct {
+	Failed int
+	Total  int
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d of %d targets failed", e.Failed, e.Total)
+}
+
+// ExitCode which distinguishes between a partial and a complete failure.
+func (e *Error) ExitCode() int {
+	if e.Failed < e.Total {
+		return ExitCodePartial
+	}
+
+	return ExitCodeFailed
+}
@@ -0,0 +1,49 @@
+// Package safety aborts a run before any monitors are removed when it would remove more than expected,
+// protecting against a listing which is unexpectedly empty or incomplete eg. the wrong namespace or missing permissions.
+package safety
+
+import (
+	"fmt"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+// MinMonitors is how many monitors a namespace needs before the percentage limit applies. Removing a single
+// monitor from a small namespace is a large percentage, and would otherwise need --force on every run.
+const MinMonitors = 10
+
+// Limits on the monitors which a single run may remove.
+type Limits struct {
+	MaxDeletes        int
+	MaxDeletesPercent int
+	Force             bool
+}
+
+// Flags which configure the safety limits.
+func (l *Limits) Flags(command *kingpin.CmdClause) {
+	command.Flag("max-deletes", "Abort if more than this many monitors would be removed, 0 for no limit").Envar("MAX_DELETES").Default("50").IntVar(&l.MaxDeletes)
+	command.Flag("max-deletes-percent", "Abort if more than this percentage of the namespace's monitors would be removed, once it has at least 10 monitors, 0 for no limit").Envar("MAX_DELETES_PERCENT").Default("25").IntVar(&l.MaxDeletesPercent)
+	command.Flag("force", "Remove monitors even when nothing which owns monitors was found in the namespace").Envar("FORCE").BoolVar(&l.Force)
+}
+
+// Check that removing a number of the namespace's monitors is within the limits. Owners is the number of
+// resources found in the namespace which own monitors, described by kind eg. "Routes".
+func (l Limits) Check(removals, monitors, owners int, kind string) error {
+	if removals == 0 {
+		return nil
+	}
+
+	if owners == 0 && !l.Force {
+		return fmt.Errorf("refusing to remove %d monitors because no %s were found, use --force if this is expected", removals, kind)
+	}
+
+	if l.MaxDeletes > 0 && removals > l.MaxDeletes {
+		return fmt.Errorf("refusing to remove %d monitors because it exceeds --max-deletes=%d", removals, l.MaxDeletes)
+	}
+
+	if l.MaxDeletesPercent > 0 && monitors >= MinMonitors && removals*100 > monitors*l.MaxDeletesPercent {
+		return fmt.Errorf("refusing to remove %d of %d monitors because it exceeds --max-deletes-percent=%d", removals, monitors, l.MaxDeletesPercent)
+	}
+
+	return nil
+}
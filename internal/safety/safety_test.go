@@ -0,0 +1,56 @@
+package safety
+
+import (
+	"testing"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+func TestFlags(t *testing.T) {
+	app := kingpin.New("test", "")
+
+	var limits Limits
+
+	limits.Flags(app.Command("cleanup", ""))
+
+	_, err := app.Parse([]string{"cleanup"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if limits.MaxDeletes == 0 || limits.MaxDeletesPercent == 0 {
+		t.Errorf("expected the limits to be on by default, got %+v", limits)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	limits := Limits{MaxDeletes: 50, MaxDeletesPercent: 25}
+
+	tests := []struct {
+		name     string
+		limits   Limits
+		removals int
+		monitors int
+		owners   int
+		fail     bool
+	}{
+		{name: "nothing to remove", limits: limits, monitors: 10},
+		{name: "within the limits", limits: limits, removals: 2, monitors: 10, owners: 8},
+		{name: "no owners", limits: limits, removals: 1, monitors: 10, fail: true},
+		{name: "no owners forced", limits: Limits{Force: true}, removals: 10, monitors: 10},
+		{name: "too many", limits: limits, removals: 51, monitors: 1000, owners: 949, fail: true},
+		{name: "too many percent", limits: limits, removals: 3, monitors: 10, owners: 7, fail: true},
+		{name: "one of two", limits: limits, removals: 1, monitors: 2, owners: 1},
+		{name: "most of a small namespace", limits: limits, removals: 8, monitors: 9, owners: 1},
+		{name: "no limits", limits: Limits{}, removals: 90, monitors: 100, owners: 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.limits.Check(test.removals, test.monitors, test.owners, "Routes")
+			if (err != nil) != test.fail {
+				t.Errorf("expected failure %v, got %v", test.fail, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package schedule
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Run a function once, or forever on an interval if one is provided.
+// When running on an interval errors are logged and the next run is still scheduled.
+func Run(interval time.Duration, fn func() error) error {
+	if interval <= 0 {
+		return fn()
+	}
+
+	for {
+		err := fn()
+		if err != nil {
+			log.Errorln("Run failed:", err)
+		}
+
+		log.Infoln("Next run in:", interval)
+
+		time.Sleep(interval)
+	}
+}
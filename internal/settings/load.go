@@ -0,0 +1,33 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/kubernetes/syntheticspolicy"
+)
+
+// Load the policies for the cluster along with the labels of the namespace which Routes are being resolved for.
+// Namespace labels are only queried when a policy selects namespaces by label.
+func Load(client dynamic.Interface, namespaces coreclient.NamespacesGetter, namespace string) ([]v1alpha1.SyntheticsPolicy, map[string]string, error) {
+	policies, err := syntheticspolicy.List(client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list policies: %w", err)
+	}
+
+	if !NeedsNamespaceLabels(policies) {
+		return policies, nil, nil
+	}
+
+	ns, err := namespaces.Namespaces().Get(context.Background(), namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get namespace: %w", err)
+	}
+
+	return policies, ns.ObjectMeta.Labels, nil
+}
@@ -0,0 +1,393 @@
+package settings
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+)
+
+const (
+	// FieldEnabled toggles whether a Route is monitored.
+	FieldEnabled = "enabled"
+	// FieldType is the type of monitor.
+	FieldType = "type"
+	// FieldFrequency is the frequency in minutes which the monitor runs.
+	FieldFrequency = "frequency"
+	// FieldLocations are the locations which the monitor runs from.
+	FieldLocations = "locations"
+	// FieldSLAThreshold is the SLA threshold in seconds.
+	FieldSLAThreshold = "slaThreshold"
+	// FieldAlertPolicyID is the alert policy which a condition for the monitor is added to.
+	FieldAlertPolicyID = "alertPolicyId"
+	// FieldManagedFields are the fields of the monitor which are kept in sync.
+	FieldManagedFields = "managedFields"
+
+	// SourceDefault is used for settings which were not overridden.
+	SourceDefault = "default"
+	// SourceAnnotation is used for settings which were set by a Route annotation.
+	SourceAnnotation = "annotation"
+	// SourcePolicyPrefix is prepended to the name of the policy which set a setting.
+	SourcePolicyPrefix = "policy/"
+)
+
+// Fields in the order which they are displayed.
+var Fields = []string{
+	FieldEnabled,
+	FieldType,
+	FieldFrequency,
+	FieldLocations,
+	FieldSLAThreshold,
+	FieldAlertPolicyID,
+	FieldManagedFields,
+}
+
+// Settings which determine how a Route is monitored.
+type Settings struct {
+	Enabled       bool
+	Type          synthetics.MonitorType
+	Frequency     uint
+	Locations     []string
+	SLAThreshold  float64
+	AlertPolicyID int
+	// ManagedFields of the monitor which are kept in sync, every field is managed when empty.
+	ManagedFields []string
+
+	// Sources records where each setting came from, keyed by field.
+	Sources map[string]string
+	// Policies which selected the Route, in the order they were applied.
+	Policies []string
+}
+
+// Defaults used when neither a policy nor an annotation sets a field.
+func Defaults(location string) Settings {
+	s := Settings{
+		Enabled:      true,
+		Type:         monitorutils.DefaultType,
+		Frequency:    monitorutils.DefaultFrequency,
+		Locations:    []string{location},
+		SLAThreshold: monitorutils.DefaultSLAThreshold,
+		Sources:      make(map[string]string, len(Fields)),
+	}
+
+	for _, field := range Fields {
+		s.Sources[field] = SourceDefault
+	}
+
+	return s
+}
+
+// Value of a field formatted for display.
+func (s Settings) Value(field string) string {
+	switch field {
+	case FieldEnabled:
+		return strconv.FormatBool(s.Enabled)
+	case FieldType:
+		return string(s.Type)
+	case FieldFrequency:
+		return strconv.FormatUint(uint64(s.Frequency), 10)
+	case FieldLocations:
+		return strings.Join(s.Locations, ",")
+	case FieldSLAThreshold:
+		return strconv.FormatFloat(s.SLAThreshold, 'f', -1, 64)
+	case FieldAlertPolicyID:
+		if s.AlertPolicyID == 0 {
+			return ""
+		}
+
+		return strconv.Itoa(s.AlertPolicyID)
+	case FieldManagedFields:
+		if len(s.ManagedFields) == 0 {
+			return "all"
+		}
+
+		return strings.Join(s.ManagedFields, ",")
+	}
+
+	return ""
+}
+
+// Monitor which is desired for a URL with these settings.
+func (s Settings) Monitor(uri string) synthetics.Monitor {
+	return synthetics.Monitor{
+		Name:         uri,
+		Type:         s.Type,
+		Frequency:    s.Frequency,
+		URI:          uri,
+		Locations:    s.Locations,
+		Status:       monitorutils.DefaultStatus,
+		SLAThreshold: s.SLAThreshold,
+	}
+}
+
+// Resolve the effective settings for a Route.
+// Precedence from lowest to highest is: defaults, policies which select the Route ordered by priority
+// (then name) and finally the Route's own annotations.
+func Resolve(defaults Settings, policies []v1alpha1.SyntheticsPolicy, namespaceLabels map[string]string, route routev1.Route) (Settings, error) {
+	s := defaults.copy()
+
+	matched, err := Select(policies, namespaceLabels, route)
+	if err != nil {
+		return s, err
+	}
+
+	for _, policy := range matched {
+		s.applyPolicy(policy)
+	}
+
+	err = s.applyAnnotations(route.ObjectMeta.Annotations)
+	if err != nil {
+		return s, err
+	}
+
+	return s, Validate(s)
+}
+
+// Select the policies which apply to a Route, ordered from lowest to highest precedence.
+func Select(policies []v1alpha1.SyntheticsPolicy, namespaceLabels map[string]string, route routev1.Route) ([]v1alpha1.SyntheticsPolicy, error) {
+	var matched []v1alpha1.SyntheticsPolicy
+
+	for _, policy := range policies {
+		ok, err := matches(policy.Spec.NamespaceSelector, namespaceLabels)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s has an invalid namespace selector: %w", policy.ObjectMeta.Name, err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		ok, err = matches(policy.Spec.RouteSelector, route.ObjectMeta.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s has an invalid route selector: %w", policy.ObjectMeta.Name, err)
+		}
+
+		if ok {
+			matched = append(matched, policy)
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		if matched[i].Spec.Priority != matched[j].Spec.Priority {
+			return matched[i].Spec.Priority < matched[j].Spec.Priority
+		}
+
+		return matched[i].ObjectMeta.Name < matched[j].ObjectMeta.Name
+	})
+
+	return matched, nil
+}
+
+// NeedsNamespaceLabels returns true if any policy selects namespaces by label.
+func NeedsNamespaceLabels(policies []v1alpha1.SyntheticsPolicy) bool {
+	for _, policy := range policies {
+		if policy.Spec.NamespaceSelector != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper function to apply the defaults declared by a policy.
+func (s *Settings) applyPolicy(policy v1alpha1.SyntheticsPolicy) {
+	source := SourcePolicyPrefix + policy.ObjectMeta.Name
+	defaults := policy.Spec.Defaults
+
+	s.Policies = append(s.Policies, policy.ObjectMeta.Name)
+
+	if defaults.Enabled != nil {
+		s.Enabled = *defaults.Enabled
+		s.Sources[FieldEnabled] = source
+	}
+
+	if defaults.Type != "" {
+		s.Type = synthetics.MonitorType(defaults.Type)
+		s.Sources[FieldType] = source
+	}
+
+	if defaults.Frequency != 0 {
+		s.Frequency = defaults.Frequency
+		s.Sources[FieldFrequency] = source
+	}
+
+	if len(defaults.Locations) > 0 {
+		s.Locations = defaults.Locations
+		s.Sources[FieldLocations] = source
+	}
+
+	if defaults.SLAThreshold != 0 {
+		s.SLAThreshold = defaults.SLAThreshold
+		s.Sources[FieldSLAThreshold] = source
+	}
+
+	if defaults.AlertPolicyID != 0 {
+		s.AlertPolicyID = defaults.AlertPolicyID
+		s.Sources[FieldAlertPolicyID] = source
+	}
+
+	if len(defaults.ManagedFields) > 0 {
+		s.ManagedFields = defaults.ManagedFields
+		s.Sources[FieldManagedFields] = source
+	}
+}
+
+// Helper function to apply the overrides declared by Route annotations.
+func (s *Settings) applyAnnotations(annotations map[string]string) error {
+	o, err := ParseAnnotations(annotations)
+	if err != nil {
+		return err
+	}
+
+	if o.Enabled != nil {
+		s.Enabled = *o.Enabled
+		s.Sources[FieldEnabled] = SourceAnnotation
+	}
+
+	if o.Type != "" {
+		s.Type = synthetics.MonitorType(o.Type)
+		s.Sources[FieldType] = SourceAnnotation
+	}
+
+	if o.Frequency != 0 {
+		s.Frequency = o.Frequency
+		s.Sources[FieldFrequency] = SourceAnnotation
+	}
+
+	if len(o.Locations) > 0 {
+		s.Locations = o.Locations
+		s.Sources[FieldLocations] = SourceAnnotation
+	}
+
+	if o.SLAThreshold != 0 {
+		s.SLAThreshold = o.SLAThreshold
+		s.Sources[FieldSLAThreshold] = SourceAnnotation
+	}
+
+	if o.AlertPolicyID != 0 {
+		s.AlertPolicyID = o.AlertPolicyID
+		s.Sources[FieldAlertPolicyID] = SourceAnnotation
+	}
+
+	if len(o.ManagedFields) > 0 {
+		s.ManagedFields = o.ManagedFields
+		s.Sources[FieldManagedFields] = SourceAnnotation
+	}
+
+	return nil
+}
+
+// ParseAnnotations returns the overrides declared by Route annotations.
+func ParseAnnotations(annotations map[string]string) (v1alpha1.PolicyDefaults, error) {
+	var (
+		o      v1alpha1.PolicyDefaults
+		errors []string
+	)
+
+	if value, ok := annotations[routeutils.AnnotationEnabled]; ok {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s must be true or false: %q", routeutils.AnnotationEnabled, value))
+		} else {
+			o.Enabled = &enabled
+		}
+	}
+
+	if value, ok := annotations[routeutils.AnnotationType]; ok {
+		o.Type = value
+	}
+
+	if value, ok := annotations[routeutils.AnnotationFrequency]; ok {
+		frequency, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s must be a number of minutes: %q", routeutils.AnnotationFrequency, value))
+		} else {
+			o.Frequency = uint(frequency)
+		}
+	}
+
+	if value, ok := annotations[routeutils.AnnotationLocations]; ok {
+		for _, location := range strings.Split(value, ",") {
+			if location = strings.TrimSpace(location); location != "" {
+				o.Locations = append(o.Locations, location)
+			}
+		}
+
+		if len(o.Locations) == 0 {
+			errors = append(errors, fmt.Sprintf("%s must contain at least one location", routeutils.AnnotationLocations))
+		}
+	}
+
+	if value, ok := annotations[routeutils.AnnotationSLAThreshold]; ok {
+		threshold, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s must be a number of seconds: %q", routeutils.AnnotationSLAThreshold, value))
+		} else {
+			o.SLAThreshold = threshold
+		}
+	}
+
+	if value, ok := annotations[routeutils.AnnotationAlertPolicyID]; ok {
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s must be a number: %q", routeutils.AnnotationAlertPolicyID, value))
+		} else {
+			o.AlertPolicyID = id
+		}
+	}
+
+	if value, ok := annotations[routeutils.AnnotationManagedFields]; ok {
+		for _, field := range strings.Split(value, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				o.ManagedFields = append(o.ManagedFields, field)
+			}
+		}
+
+		if len(o.ManagedFields) == 0 {
+			errors = append(errors, fmt.Sprintf("%s must contain at least one field", routeutils.AnnotationManagedFields))
+		}
+	}
+
+	if len(errors) > 0 {
+		return o, fmt.Errorf("invalid annotations: %s", strings.Join(errors, ", "))
+	}
+
+	return o, nil
+}
+
+// Helper function to copy settings so that policies do not modify the defaults.
+func (s Settings) copy() Settings {
+	c := s
+	c.Locations = append([]string(nil), s.Locations...)
+	c.ManagedFields = append([]string(nil), s.ManagedFields...)
+	c.Sources = make(map[string]string, len(s.Sources))
+
+	for field, source := range s.Sources {
+		c.Sources[field] = source
+	}
+
+	return c
+}
+
+// Helper function to check if a label selector matches a set of labels. An empty selector matches everything.
+func matches(selector *metav1.LabelSelector, set map[string]string) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+
+	return s.Matches(labels.Set(set)), nil
+}
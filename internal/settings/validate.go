@@ -0,0 +1,284 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/newrelic/newrelic-client-go/pkg/synthetics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+	monitorutils "github.com/codedropau/openshift-newrelic-synthetics/internal/newrelic/monitor"
+)
+
+// Frequencies in minutes which are accepted by New Relic.
+var Frequencies = []uint{1, 5, 10, 15, 30, 60, 360, 720, 1440}
+
+// Types of monitor which are accepted by New Relic.
+var Types = []synthetics.MonitorType{
+	synthetics.MonitorTypes.Ping,
+	synthetics.MonitorTypes.Browser,
+	synthetics.MonitorTypes.ScriptedBrowser,
+	synthetics.MonitorTypes.APITest,
+}
+
+// RouteTypes of monitor which can be used for Routes. Scripted monitors need a script, which only a SyntheticMonitor can provide.
+var RouteTypes = []synthetics.MonitorType{
+	synthetics.MonitorTypes.Ping,
+	synthetics.MonitorTypes.Browser,
+}
+
+// Statuses of monitor which are accepted by New Relic.
+var Statuses = []synthetics.MonitorStatusType{
+	synthetics.MonitorStatus.Enabled,
+	synthetics.MonitorStatus.Muted,
+	synthetics.MonitorStatus.Disabled,
+}
+
+// Validate the settings resolved for a Route before they are sent to New Relic.
+// Routes can't provide a script, so scripted types are rejected here as well as by the webhook.
+func Validate(s Settings) error {
+	errors := validateDefaults(v1alpha1.PolicyDefaults{
+		Type:          string(s.Type),
+		Frequency:     s.Frequency,
+		Locations:     s.Locations,
+		SLAThreshold:  s.SLAThreshold,
+		AlertPolicyID: s.AlertPolicyID,
+		ManagedFields: s.ManagedFields,
+	})
+
+	errors = append(errors, validateRouteType(string(s.Type))...)
+
+	if s.Type == "" {
+		errors = append(errors, "type is required")
+	}
+
+	if s.Frequency == 0 {
+		errors = append(errors, "frequency is required")
+	}
+
+	if len(s.Locations) == 0 {
+		errors = append(errors, "at least one location is required")
+	}
+
+	if s.SLAThreshold <= 0 {
+		errors = append(errors, fmt.Sprintf("sla threshold %v must be greater than zero", s.SLAThreshold))
+	}
+
+	return join("invalid settings", errors)
+}
+
+// ValidateAnnotations checks the overrides declared by Route annotations.
+func ValidateAnnotations(annotations map[string]string) error {
+	o, err := ParseAnnotations(annotations)
+	if err != nil {
+		return err
+	}
+
+	return join("invalid annotations", append(validateDefaults(o), validateRouteType(o.Type)...))
+}
+
+// ValidatePolicy checks the selectors and defaults declared by a SyntheticsPolicy.
+func ValidatePolicy(policy v1alpha1.SyntheticsPolicy) error {
+	errors := append(validateDefaults(policy.Spec.Defaults), validateRouteType(policy.Spec.Defaults.Type)...)
+
+	if policy.Spec.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector); err != nil {
+			errors = append(errors, fmt.Sprintf("namespace selector is invalid: %s", err))
+		}
+	}
+
+	if policy.Spec.RouteSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(policy.Spec.RouteSelector); err != nil {
+			errors = append(errors, fmt.Sprintf("route selector is invalid: %s", err))
+		}
+	}
+
+	return join("invalid policy", errors)
+}
+
+// ValidateMonitor checks the spec of a SyntheticMonitor.
+func ValidateMonitor(spec v1alpha1.SyntheticMonitorSpec) error {
+	errors := validateDefaults(v1alpha1.PolicyDefaults{
+		Type:         spec.Type,
+		Frequency:    spec.Frequency,
+		Locations:    spec.Locations,
+		SLAThreshold: spec.SLAThreshold,
+	})
+
+	if spec.Target.Route == nil && spec.Target.URL == "" {
+		errors = append(errors, "target must be a route or url")
+	}
+
+	if spec.Target.Route != nil && spec.Target.URL != "" {
+		errors = append(errors, "target must be a route or url, not both")
+	}
+
+	if spec.Target.Route != nil && spec.Target.Route.Name == "" {
+		errors = append(errors, "target route name is required")
+	}
+
+	if spec.Status != "" && !validStatus(synthetics.MonitorStatusType(spec.Status)) {
+		errors = append(errors, fmt.Sprintf("status %q must be one of %s", spec.Status, joinStatuses(Statuses)))
+	}
+
+	if spec.Script != nil && (spec.Script.ConfigMap == "" || spec.Script.Key == "") {
+		errors = append(errors, "script requires a configmap and key")
+	}
+
+	if spec.Alerts != nil && spec.Alerts.PolicyID <= 0 {
+		errors = append(errors, "alerts require a policy id")
+	}
+
+	return join("invalid monitor", errors)
+}
+
+// ValidateLocations checks locations against the list which is known to New Relic.
+func ValidateLocations(locations, known []string) error {
+	var errors []string
+
+	for _, location := range locations {
+		if !contains(known, location) {
+			errors = append(errors, fmt.Sprintf("location %q is unknown", location))
+		}
+	}
+
+	return join("invalid locations", errors)
+}
+
+// Helper function to check the fields which have been set.
+func validateDefaults(d v1alpha1.PolicyDefaults) []string {
+	var errors []string
+
+	if d.Type != "" && !validType(synthetics.MonitorType(d.Type)) {
+		errors = append(errors, fmt.Sprintf("type %q must be one of %s", d.Type, joinTypes(Types)))
+	}
+
+	if d.Frequency != 0 && !validFrequency(d.Frequency) {
+		errors = append(errors, fmt.Sprintf("frequency %d must be one of %s", d.Frequency, joinFrequencies(Frequencies)))
+	}
+
+	for _, location := range d.Locations {
+		if strings.TrimSpace(location) == "" {
+			errors = append(errors, "locations must not be empty")
+			break
+		}
+	}
+
+	if d.SLAThreshold < 0 {
+		errors = append(errors, fmt.Sprintf("sla threshold %v must be greater than zero", d.SLAThreshold))
+	}
+
+	if d.AlertPolicyID < 0 {
+		errors = append(errors, fmt.Sprintf("alert policy id %d must be a positive number", d.AlertPolicyID))
+	}
+
+	for _, field := range d.ManagedFields {
+		if !contains(monitorutils.OwnableFields, field) {
+			errors = append(errors, fmt.Sprintf("managed field %q must be one of %s", field, strings.Join(monitorutils.OwnableFields, ", ")))
+		}
+	}
+
+	return errors
+}
+
+// Helper function to check that a type which is accepted by New Relic can also be used for Routes.
+func validateRouteType(t string) []string {
+	if t == "" || !validType(synthetics.MonitorType(t)) {
+		return nil
+	}
+
+	for _, valid := range RouteTypes {
+		if synthetics.MonitorType(t) == valid {
+			return nil
+		}
+	}
+
+	return []string{fmt.Sprintf("type %q requires a script, use a SyntheticMonitor or one of %s", t, joinTypes(RouteTypes))}
+}
+
+// Helper function to combine validation errors into a single error.
+func join(prefix string, errors []string) error {
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", prefix, strings.Join(errors, ", "))
+}
+
+// Helper function to check if a monitor type is accepted by New Relic.
+func validType(t synthetics.MonitorType) bool {
+	for _, valid := range Types {
+		if t == valid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper function to check if a monitor status is accepted by New Relic.
+func validStatus(status synthetics.MonitorStatusType) bool {
+	for _, valid := range Statuses {
+		if status == valid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper function to check if a frequency is accepted by New Relic.
+func validFrequency(frequency uint) bool {
+	for _, valid := range Frequencies {
+		if frequency == valid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper function to check if a list contains a value.
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper function to format the list of monitor types for an error message.
+func joinTypes(types []synthetics.MonitorType) string {
+	list := make([]string, len(types))
+
+	for i, t := range types {
+		list[i] = string(t)
+	}
+
+	return strings.Join(list, ", ")
+}
+
+// Helper function to format the list of monitor statuses for an error message.
+func joinStatuses(statuses []synthetics.MonitorStatusType) string {
+	list := make([]string, len(statuses))
+
+	for i, status := range statuses {
+		list[i] = string(status)
+	}
+
+	return strings.Join(list, ", ")
+}
+
+// Helper function to format the list of frequencies for an error message.
+func joinFrequencies(frequencies []uint) string {
+	list := make([]string, len(frequencies))
+
+	for i, frequency := range frequencies {
+		list[i] = fmt.Sprint(frequency)
+	}
+
+	return strings.Join(list, ", ")
+}
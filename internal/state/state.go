@@ -0,0 +1,198 @@
+// Package state persists state between runs in a file or a ConfigMap.
+package state
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// Store which state is persisted in.
+type Store interface {
+	// Load the state into a value, returning false if nothing has been saved yet.
+	Load(v interface{}) (bool, error)
+	// Save the state from a value.
+	Save(v interface{}) error
+	// String describes where the state is stored.
+	String() string
+}
+
+// File which state is stored in as JSON.
+func File(path string) Store {
+	return &fileStore{path: path}
+}
+
+// ConfigMap which state is stored in, under a key which is compressed to keep large state under the size limit
+// of a ConfigMap. Other keys in the ConfigMap are left as they are, so it can be shared. The reference is in the
+// form "namespace/name".
+func ConfigMap(client coreclient.ConfigMapsGetter, ref, key string) (Store, error) {
+	parts := strings.Split(ref, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("ConfigMap must be in the form namespace/name: %q", ref)
+	}
+
+	return &configMapStore{
+		client:    client,
+		namespace: parts[0],
+		name:      parts[1],
+		key:       key,
+	}, nil
+}
+
+// State which is stored in a file.
+type fileStore struct {
+	path string
+}
+
+func (s *fileStore) String() string {
+	return s.path
+}
+
+// Load the state from the file.
+func (s *fileStore) Load(v interface{}) (bool, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	err = json.Unmarshal(data, v)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", s.path, err)
+	}
+
+	return true, nil
+}
+
+// Save the state to the file. The file is replaced in one step so an interrupted run can't leave partial state behind.
+func (s *fileStore) Save(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// State which is stored in a ConfigMap.
+type configMapStore struct {
+	client    coreclient.ConfigMapsGetter
+	namespace string
+	name      string
+	key       string
+}
+
+func (s *configMapStore) String() string {
+	return fmt.Sprintf("configmap/%s/%s/%s", s.namespace, s.name, s.key)
+}
+
+// Load the state from the ConfigMap.
+func (s *configMapStore) Load(v interface{}) (bool, error) {
+	configMap, err := s.client.ConfigMaps(s.namespace).Get(context.Background(), s.name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	data, ok := configMap.BinaryData[s.key]
+	if !ok {
+		return false, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return false, fmt.Errorf("failed to decompress %s: %w", s.key, err)
+	}
+
+	defer reader.Close()
+
+	err = json.NewDecoder(reader).Decode(v)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", s.key, err)
+	}
+
+	return true, nil
+}
+
+// Save the state to the ConfigMap, creating it if it doesn't exist.
+func (s *configMapStore) Save(v interface{}) error {
+	var buf bytes.Buffer
+
+	writer := gzip.NewWriter(&buf)
+
+	err := json.NewEncoder(writer).Encode(v)
+	if err != nil {
+		return err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return err
+	}
+
+	configMaps := s.client.ConfigMaps(s.namespace)
+
+	configMap, err := configMaps.Get(context.Background(), s.name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		_, err = configMaps.Create(context.Background(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: s.namespace,
+				Name:      s.name,
+			},
+			BinaryData: map[string][]byte{
+				s.key: buf.Bytes(),
+			},
+		}, metav1.CreateOptions{})
+
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if configMap.BinaryData == nil {
+		configMap.BinaryData = make(map[string][]byte)
+	}
+
+	configMap.BinaryData[s.key] = buf.Bytes()
+
+	_, err = configMaps.Update(context.Background(), configMap, metav1.UpdateOptions{})
+
+	return err
+}
@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+
+	routev1 "github.com/openshift/api/route/v1"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/settings"
+)
+
+// Route validates the synthetics annotations on a Route.
+// Locations are checked against the known list when it is not empty.
+func Route(locations []string) Validator {
+	return func(raw []byte) error {
+		var route routev1.Route
+
+		err := json.Unmarshal(raw, &route)
+		if err != nil {
+			return fmt.Errorf("failed to decode route: %w", err)
+		}
+
+		err = settings.ValidateAnnotations(route.ObjectMeta.Annotations)
+		if err != nil {
+			return err
+		}
+
+		if len(locations) == 0 {
+			return nil
+		}
+
+		o, err := settings.ParseAnnotations(route.ObjectMeta.Annotations)
+		if err != nil {
+			return err
+		}
+
+		return settings.ValidateLocations(o.Locations, locations)
+	}
+}
+
+// SyntheticsPolicy validates a SyntheticsPolicy.
+// Locations are checked against the known list when it is not empty.
+func SyntheticsPolicy(locations []string) Validator {
+	return func(raw []byte) error {
+		var policy v1alpha1.SyntheticsPolicy
+
+		err := json.Unmarshal(raw, &policy)
+		if err != nil {
+			return fmt.Errorf("failed to decode policy: %w", err)
+		}
+
+		err = settings.ValidatePolicy(policy)
+		if err != nil {
+			return err
+		}
+
+		if len(locations) == 0 {
+			return nil
+		}
+
+		return settings.ValidateLocations(policy.Spec.Defaults.Locations, locations)
+	}
+}
+
+// SyntheticMonitor validates a SyntheticMonitor.
+// Locations are checked against the known list when it is not empty.
+func SyntheticMonitor(locations []string) Validator {
+	return func(raw []byte) error {
+		var sm v1alpha1.SyntheticMonitor
+
+		err := json.Unmarshal(raw, &sm)
+		if err != nil {
+			return fmt.Errorf("failed to decode monitor: %w", err)
+		}
+
+		err = settings.ValidateMonitor(sm.Spec)
+		if err != nil {
+			return err
+		}
+
+		if len(locations) == 0 {
+			return nil
+		}
+
+		return settings.ValidateLocations(sm.Spec.Locations, locations)
+	}
+}
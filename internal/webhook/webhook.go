@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Validator checks the raw object under review, returning an error which is shown to the user when it is denied.
+type Validator func(raw []byte) error
+
+// Handler which responds to AdmissionReview requests using a Validator.
+func Handler(validate Validator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var review admissionv1.AdmissionReview
+
+		err := json.NewDecoder(r.Body).Decode(&review)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to decode admission review: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		if review.Request == nil {
+			http.Error(w, "admission review does not contain a request", http.StatusBadRequest)
+			return
+		}
+
+		review.Response = respond(review.Request, validate)
+		review.Request = nil
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(review)
+		if err != nil {
+			log.Errorln("Failed to encode admission review:", err)
+		}
+	}
+}
+
+// Helper function to build the response for an admission request.
+func respond(request *admissionv1.AdmissionRequest, validate Validator) *admissionv1.AdmissionResponse {
+	response := &admissionv1.AdmissionResponse{
+		UID:     request.UID,
+		Allowed: true,
+	}
+
+	// Nothing to validate once an object is being removed.
+	if request.Operation == admissionv1.Delete {
+		return response
+	}
+
+	logger := log.WithFields(log.Fields{
+		"kind":      request.Kind.Kind,
+		"namespace": request.Namespace,
+		"name":      request.Name,
+	})
+
+	err := validate(request.Object.Raw)
+	if err != nil {
+		logger.Infoln("Denied:", err)
+
+		response.Allowed = false
+		response.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Reason:  metav1.StatusReasonInvalid,
+			Code:    http.StatusUnprocessableEntity,
+			Message: err.Error(),
+		}
+	}
+
+	return response
+}
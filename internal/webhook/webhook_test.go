@@ -0,0 +1,252 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/codedropau/openshift-newrelic-synthetics/internal/apis/synthetics/v1alpha1"
+	routeutils "github.com/codedropau/openshift-newrelic-synthetics/internal/openshift/route"
+)
+
+func newRoute(annotations map[string]string) *routev1.Route {
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "test",
+			Name:        "web",
+			Annotations: annotations,
+		},
+		Spec: routev1.RouteSpec{
+			Host: "web.example.com",
+		},
+	}
+}
+
+// Helper function to send an AdmissionReview to a handler and return its response.
+func review(t *testing.T, handler http.Handler, operation admissionv1.Operation, object interface{}) *admissionv1.AdmissionResponse {
+	raw, err := json.Marshal(object)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "admission.k8s.io/v1",
+			Kind:       "AdmissionReview",
+		},
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID("abc"),
+			Operation: operation,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body)
+	}
+
+	var response admissionv1.AdmissionReview
+
+	err = json.NewDecoder(w.Body).Decode(&response)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.Response == nil || response.Response.UID != "abc" {
+		t.Fatalf("expected a response for the request, got %+v", response.Response)
+	}
+
+	return response.Response
+}
+
+func TestRoute(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		operation   admissionv1.Operation
+		allowed     bool
+	}{
+		{
+			name:    "no annotations",
+			allowed: true,
+		},
+		{
+			name: "valid",
+			annotations: map[string]string{
+				routeutils.AnnotationType:          "SIMPLE",
+				routeutils.AnnotationFrequency:     "5",
+				routeutils.AnnotationLocations:     "AWS_AP_SOUTHEAST_2",
+				routeutils.AnnotationManagedFields: "type,locations",
+			},
+			allowed: true,
+		},
+		{
+			name:        "bad frequency",
+			annotations: map[string]string{routeutils.AnnotationFrequency: "2"},
+		},
+		{
+			name:        "bad managed fields",
+			annotations: map[string]string{routeutils.AnnotationManagedFields: "type,name"},
+		},
+		{
+			name:        "bad type",
+			annotations: map[string]string{routeutils.AnnotationType: "PING"},
+		},
+		{
+			name:        "scripted browser",
+			annotations: map[string]string{routeutils.AnnotationType: "SCRIPT_BROWSER"},
+		},
+		{
+			name:        "scripted api",
+			annotations: map[string]string{routeutils.AnnotationType: "SCRIPT_API"},
+		},
+		{
+			name:        "unknown location",
+			annotations: map[string]string{routeutils.AnnotationLocations: "MARS"},
+		},
+		{
+			name:        "deleted",
+			annotations: map[string]string{routeutils.AnnotationFrequency: "2"},
+			operation:   admissionv1.Delete,
+			allowed:     true,
+		},
+	}
+
+	handler := Handler(Route([]string{"AWS_AP_SOUTHEAST_2"}))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			operation := test.operation
+			if operation == "" {
+				operation = admissionv1.Create
+			}
+
+			response := review(t, handler, operation, newRoute(test.annotations))
+
+			if response.Allowed != test.allowed {
+				t.Fatalf("expected allowed %v, got %+v", test.allowed, response)
+			}
+
+			if !response.Allowed && (response.Result == nil || response.Result.Code != http.StatusUnprocessableEntity || response.Result.Message == "") {
+				t.Errorf("expected the reason to be returned, got %+v", response.Result)
+			}
+		})
+	}
+}
+
+func TestSyntheticsPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults v1alpha1.PolicyDefaults
+		allowed  bool
+	}{
+		{
+			name:     "valid",
+			defaults: v1alpha1.PolicyDefaults{Type: "BROWSER", Frequency: 15, ManagedFields: []string{"frequency"}},
+			allowed:  true,
+		},
+		{
+			name:     "bad frequency",
+			defaults: v1alpha1.PolicyDefaults{Frequency: 2},
+		},
+		{
+			name:     "bad managed fields",
+			defaults: v1alpha1.PolicyDefaults{ManagedFields: []string{"uri"}},
+		},
+		{
+			name:     "scripted type",
+			defaults: v1alpha1.PolicyDefaults{Type: "SCRIPT_API"},
+		},
+	}
+
+	handler := Handler(SyntheticsPolicy(nil))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy := &v1alpha1.SyntheticsPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       v1alpha1.SyntheticsPolicySpec{Defaults: test.defaults},
+			}
+
+			if response := review(t, handler, admissionv1.Update, policy); response.Allowed != test.allowed {
+				t.Errorf("expected allowed %v, got %+v", test.allowed, response)
+			}
+		})
+	}
+}
+
+func TestSyntheticMonitor(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    v1alpha1.SyntheticMonitorSpec
+		allowed bool
+	}{
+		{
+			name: "scripted",
+			spec: v1alpha1.SyntheticMonitorSpec{
+				Target: v1alpha1.Target{URL: "https://example.com"},
+				Type:   "SCRIPT_API",
+				Script: &v1alpha1.ScriptReference{ConfigMap: "scripts", Key: "health.js"},
+			},
+			allowed: true,
+		},
+		{
+			name: "bad frequency",
+			spec: v1alpha1.SyntheticMonitorSpec{
+				Target:    v1alpha1.Target{URL: "https://example.com"},
+				Frequency: 2,
+			},
+		},
+		{
+			name: "bad type",
+			spec: v1alpha1.SyntheticMonitorSpec{
+				Target: v1alpha1.Target{URL: "https://example.com"},
+				Type:   "PING",
+			},
+		},
+	}
+
+	handler := Handler(SyntheticMonitor(nil))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sm := &v1alpha1.SyntheticMonitor{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "health"},
+				Spec:       test.spec,
+			}
+
+			if response := review(t, handler, admissionv1.Create, sm); response.Allowed != test.allowed {
+				t.Errorf("expected allowed %v, got %+v", test.allowed, response)
+			}
+		})
+	}
+}
+
+func TestHandlerBadRequest(t *testing.T) {
+	handler := Handler(Route(nil))
+
+	for _, body := range []string{"{", `{"kind": "AdmissionReview"}`} {
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body)))
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d for %q, got %d", http.StatusBadRequest, body, w.Code)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"sync"
+)
+
+// Pool which runs tasks with a bounded level of concurrency.
+type Pool struct {
+	sem chan struct{}
+	wg  sync.WaitGroup
+
+	mu  sync.Mutex
+	err error
+}
+
+// New pool which runs at most the given number of tasks at once.
+func New(concurrency int) *Pool {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &Pool{
+		sem: make(chan struct{}, concurrency),
+	}
+}
+
+// Go runs a task once a worker is available.
+func (p *Pool) Go(task func() error) {
+	p.wg.Add(1)
+	p.sem <- struct{}{}
+
+	go func() {
+		defer func() {
+			<-p.sem
+			p.wg.Done()
+		}()
+
+		if err := task(); err != nil {
+			p.mu.Lock()
+			if p.err == nil {
+				p.err = err
+			}
+			p.mu.Unlock()
+		}
+	}()
+}
+
+// Wait for all tasks to complete, returning the first error encountered.
+func (p *Pool) Wait() error {
+	p.wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.err
+}
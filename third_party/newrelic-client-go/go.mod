@@ -0,0 +1,22 @@
+module github.com/newrelic/newrelic-client-go
+
+go 1.15
+
+require (
+	github.com/client9/misspell v0.3.4
+	github.com/git-chglog/git-chglog v0.0.0-20200414013904-db796966b373
+	github.com/golangci/golangci-lint v1.32.2
+	github.com/google/go-querystring v1.0.0
+	github.com/goreleaser/goreleaser v0.147.2
+	github.com/hashicorp/go-retryablehttp v0.6.8
+	github.com/imdario/mergo v0.3.11
+	github.com/llorllale/go-gitlint v0.0.0-20200802191503-5984945d4b80
+	github.com/mitchellh/mapstructure v1.3.3
+	github.com/newrelic/tutone v0.2.5
+	github.com/psampaz/go-mod-outdated v0.7.0
+	github.com/sirupsen/logrus v1.7.0
+	github.com/stretchr/testify v1.6.1
+	github.com/tomnomnom/linkheader v0.0.0-20180905144013-02ca5825eb80
+	golang.org/x/tools v0.0.0-20201013201025-64a9e34f3752
+	gotest.tools/gotestsum v0.6.0
+)
@@ -0,0 +1,4 @@
+package version
+
+// Version of this library
+const Version string = "0.50.0"
@@ -0,0 +1,15 @@
+/*
+Package newrelic is a convenience package that provides a programmatic API surface
+area for interacting with all the New Relic One products encompassed by this project.
+Refer to each package's documentation to learn more about its capabilities.
+
+Authentication
+
+You will need a valid Personal API to communicate with the backend New Relic
+APIs that this client communicates with.  See the API key documentation below
+for more information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package newrelic
@@ -0,0 +1,14 @@
+/*
+Package accounts provides a programmatic API for interacting with New Relic accounts.
+It can be used to retrieve details about all accounts the user is authorized to view.
+
+Authentication
+
+You will need a valid Personal API key to communicate with the backend New Relic
+API that provides this functionality.  See the API key documentation below for
+more information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package accounts
@@ -0,0 +1,29 @@
+package cloud
+
+import (
+	"github.com/newrelic/newrelic-client-go/internal/http"
+	"github.com/newrelic/newrelic-client-go/internal/logging"
+	"github.com/newrelic/newrelic-client-go/pkg/config"
+)
+
+type Cloud struct {
+	client http.Client
+	config config.Config
+	logger logging.Logger
+	pager  http.Pager
+}
+
+func New(config config.Config) Cloud {
+
+	client := http.NewClient(config)
+	client.SetAuthStrategy(&http.PersonalAPIKeyCapableV2Authorizer{})
+
+	pkg := Cloud{
+		client: client,
+		config: config,
+		logger: config.GetLogger(),
+		pager:  &http.LinkHeaderPager{},
+	}
+
+	return pkg
+}
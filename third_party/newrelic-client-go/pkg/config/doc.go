@@ -0,0 +1,14 @@
+/*
+Package config provides a configuration surface area for configuring the underlying
+client.
+
+Authentication
+
+You will need a valid Personal API key to communicate with the backend New Relic
+APIs that power the client. See the API key documentation below for more
+information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package config
@@ -0,0 +1,18 @@
+/*
+Package dashboards provides a programmatic API for interacting with New Relic
+Insights dashboards.  It can be used to create, read, update and delete
+dashboards.  Refer to the Insights dashboard documentation for more information
+on working with these resources:
+
+https://docs.newrelic.com/docs/insights/insights-api/manage-dashboards/insights-dashboard-api
+
+Authentication
+
+You will need a Personal API key to communicate with the backend New Relic API
+that provides this functionality. See the API key documentation below for
+more information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package dashboards
@@ -0,0 +1,15 @@
+/*
+Package edge provides a programmatic API for interacting with New Relic Edge with
+infinite tracing. It can be used to enable New Relic Edge and retrieve relevant
+config parameters.
+
+Authentication
+
+You will need a valid Personal API key to communicate with the backend New Relic
+API that provides this functionality.  See the API key documentation below for
+more information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package edge
@@ -0,0 +1,18 @@
+/*
+Package entities provides a programmatic API for interacting with New Relic One
+entities.  It can be used for a variety of operations, including:
+
+- Searching and reading New Relic One entities
+
+- Creating, reading, updating, and deleting New Relic One entity tags
+
+Authentication
+
+You will need a valid Personal API key to communicate with the backend New Relic
+API that provides this functionality.  See the API key documentation below for
+more information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package entities
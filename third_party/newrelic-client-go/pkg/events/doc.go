@@ -0,0 +1,13 @@
+/*
+Package events provides a programmatic API for creating custom events in New Relic.
+
+Authentication
+
+You will need a valid Insights insert key to communicate with the backend New Relic
+API that provides this functionality.  See the API key documentation below for
+more information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package events
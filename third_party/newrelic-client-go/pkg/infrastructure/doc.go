@@ -0,0 +1,6 @@
+/*
+Package infrastructure provides metadata about the underlying Infrastructure API,
+which is only used to provision Infrastructure alert conditions.  The programmatic
+API for working with these resources can be found in the `alerts` package.
+*/
+package infrastructure
@@ -0,0 +1,18 @@
+/*
+Package nerdgraph provides a programmatic API for interacting with the New Relic
+NerdGraph GraphQL API.  It can be used to run freeform queries and mutations. The
+GraphiQL API explorer is a useful resource for learning about what is available via
+the NerdGraph API:
+
+https://api.newrelic.com/graphiql?#
+
+Authentication
+
+You will need a valid Personal API key to communicate with the backend New Relic
+API that provides this functionality.  See the API key documentation below for
+more information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package nerdgraph
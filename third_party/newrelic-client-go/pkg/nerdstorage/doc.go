@@ -0,0 +1,20 @@
+/*
+Package nerdstorage provides a programmatic API for interacting with the New Relic
+One NerdStorage document store.  It can be used to write NerdStorage documents
+into collections and to delete documents and collections.
+
+More information about the NerdStorage document store can be found in the online
+documentation:
+
+https://developer.newrelic.com/build-tools/new-relic-one-applications/nerdstorage
+
+Authentication
+
+You will need a valid Personal API key to communicate with the backend New Relic
+API that provides this functionality.  See the API key documentation below for
+more information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package nerdstorage
@@ -0,0 +1,17 @@
+/*
+Package nrdb provides a programmatic API for interacting with the New Relic
+Database.  It can be used to run freeform NRQL queries. Details on how to use
+the New Relic Query Language are available here:
+
+https://docs.newrelic.com/docs/query-data/nrql-new-relic-query-language/getting-started/introduction-nrql
+
+Authentication
+
+You will need a valid Personal API key to communicate with the backend New Relic
+API that provides this functionality.  See the API key documentation below for
+more information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package nrdb
@@ -0,0 +1,20 @@
+/*
+Package plugins provides a programmatic API for interacting with the New Relic
+Plugins product.  It can be used for a variety of operations, including:
+
+- Reading plugins
+
+- Reading plugin components
+
+- Reading plugin component metric data
+
+Authentication
+
+You will need a Personal API key to communicate with the backend New Relic APIs
+that provide this functionality. See the API key documentation below for more
+information on how to locate this key:
+
+https://docs.newrelic.com/docs/apis/get-started/intro-apis/types-new-relic-api-keys
+
+*/
+package plugins
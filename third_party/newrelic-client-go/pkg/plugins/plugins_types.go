@@ -0,0 +1,83 @@
+package plugins
+
+import "time"
+
+// Component represents information about a New Relic Plugins component.
+type Component struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name,omitempty"`
+	HealthStatus   string          `json:"health_status,omitempty"`
+	SummaryMetrics []SummaryMetric `json:"summary_metrics"`
+}
+
+// ComponentMetric represents metric information for a specific component.
+type ComponentMetric struct {
+	Name   string   `json:"name,omitempty"`
+	Values []string `json:"values"`
+}
+
+// SummaryMetric represents summary information for a specific metric.
+type SummaryMetric struct {
+	ID            int             `json:"id"`
+	Name          string          `json:"name"`
+	Metric        string          `json:"metric"`
+	ValueFunction string          `json:"value_function"`
+	Thresholds    MetricThreshold `json:"thresholds"`
+	Values        MetricValue     `json:"values"`
+}
+
+// MetricThreshold represents a threshold value for a metric.
+type MetricThreshold struct {
+	Caution  float64 `json:"caution"`
+	Critical float64 `json:"critical"`
+}
+
+// MetricValue represents the observed value of a metric.
+type MetricValue struct {
+	Raw       float64 `json:"raw"`
+	Formatted string  `json:"formatted"`
+}
+
+// Metric represents data for a specific metric.
+type Metric struct {
+	Name       string            `json:"name"`
+	Timeslices []MetricTimeslice `json:"timeslices"`
+}
+
+// MetricTimeslice represents the values of a metric over a given time.
+type MetricTimeslice struct {
+	From   *time.Time         `json:"from,omitempty"`
+	To     *time.Time         `json:"to,omitempty"`
+	Values map[string]float64 `json:"values,omitempty"`
+}
+
+// Plugin represents information about a New Relic plugin.
+type Plugin struct {
+	ID                  int             `json:"id"`
+	Name                string          `json:"name,omitempty"`
+	GUID                string          `json:"guid,omitempty"`
+	Publisher           string          `json:"publisher,omitempty"`
+	ComponentAgentCount int             `json:"component_agent_count"`
+	Details             PluginDetails   `json:"details"`
+	SummaryMetrics      []SummaryMetric `json:"summary_metrics"`
+}
+
+// PluginDetails represents information about a New Relic plugin.
+type PluginDetails struct {
+	Description           string `json:"description"`
+	CreatedAt             string `json:"created_at,omitempty"`
+	UpdatedAt             string `json:"updated_at,omitempty"`
+	LastPublishedAt       string `json:"last_published_at,omitempty"`
+	BrandingImageURL      string `json:"branding_image_url"`
+	UpgradedAt            string `json:"upgraded_at,omitempty"`
+	ShortName             string `json:"short_name"`
+	PublisherAboutURL     string `json:"publisher_about_url"`
+	PublisherSupportURL   string `json:"publisher_support_url"`
+	DownloadURL           string `json:"download_url"`
+	FirstEditedAt         string `json:"first_edited_at,omitempty"`
+	LastEditedAt          string `json:"last_edited_at,omitempty"`
+	FirstPublishedAt      string `json:"first_published_at,omitempty"`
+	PublishedVersion      string `json:"published_version"`
+	HasUnpublishedChanges bool   `json:"has_unpublished_changes"`
+	IsPublic              bool   `json:"is_public"`
+}